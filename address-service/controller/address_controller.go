@@ -3,6 +3,7 @@ package controller
 import (
 	"address-service/grpc_client"
 	pb "address-service/proto/address"
+	"address-service/svcauth"
 	"context"
 	"fmt"
 	"strconv"
@@ -37,21 +38,11 @@ func (ac *AddressController) List(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
-    type AddressResponse struct {
-        ID      uint32 `json:"id"`
-        Name    string `json:"name"`
-        Desc    string `json:"desc"`
-        OwnerID string `json:"owner_id"`
-    }
-
-    var out []AddressResponse
+    var out []fiber.Map
     for _, addr := range resp.Addresses {
-        out = append(out, AddressResponse{
-            ID:      addr.Id,
-            Name:    addr.Name,
-            Desc:    addr.Desc,
-            OwnerID: userInfo.Email, // ganti owner_id angka → email
-        })
+        m := addressJSON(addr)
+        m["owner_id"] = userInfo.Email // ganti owner_id angka → email
+        out = append(out, m)
     }
 
     return c.JSON(out)
@@ -65,12 +56,19 @@ func (ac *AddressController) Get(c *fiber.Ctx) error {
 
 	userID := c.Locals("user_id").(uint32) // dari JWT
 
+	// ?version=N mengambil isi alamat pada versi tersebut
+	version := c.QueryInt("version", 0)
+	if version < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid version"})
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	resp, err := ac.Client.GetAddress(ctx, &pb.GetAddressRequest{
 		Id:       uint32(id),
 		OwnerId:  userID, // kirim ke gRPC untuk validasi
+		Version:  uint32(version),
 	})
 	if err != nil {
 		st, ok := status.FromError(err)
@@ -79,6 +77,9 @@ func (ac *AddressController) Get(c *fiber.Ctx) error {
 				"error": "you are not the owner of this address",
 			})
 		}
+		if ok && st.Code() == codes.NotFound {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": st.Message()})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
 
@@ -87,12 +88,8 @@ func (ac *AddressController) Get(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
 
-	out := fiber.Map{
-		"id":         resp.Address.Id,
-		"name":       resp.Address.Name,
-		"desc":       resp.Address.Desc,
-		"owner_id":   userInfo.Email,
-	}
+	out := addressJSON(resp.Address)
+	out["owner_id"] = userInfo.Email
 
 	return c.JSON(out)
 }
@@ -100,10 +97,7 @@ func (ac *AddressController) Get(c *fiber.Ctx) error {
 func (ac *AddressController) Create(c *fiber.Ctx) error {
 	userID := c.Locals("user_id").(uint32)
 
-	var body struct {
-		Name string `json:"name"`
-		Desc string `json:"desc"`
-	}
+	var body addressInput
 	if err := c.BodyParser(&body); err != nil {
 		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
 	}
@@ -112,15 +106,28 @@ func (ac *AddressController) Create(c *fiber.Ctx) error {
 	defer cancel()
 
 	resp, err := ac.Client.CreateAddress(ctx, &pb.CreateAddressRequest{
-		Name:     body.Name,
-		Desc:     body.Desc,
-		OwnerId:  uint32(userID),
+		Name:        body.Name,
+		Desc:        body.Desc,
+		OwnerId:     uint32(userID),
+		Recipient:   body.Recipient,
+		Phone:       body.Phone,
+		StreetLine1: body.StreetLine1,
+		StreetLine2: body.StreetLine2,
+		City:        body.City,
+		Province:    body.Province,
+		PostalCode:  body.PostalCode,
+		CountryCode: body.CountryCode,
+		Latitude:    body.Latitude,
+		Longitude:   body.Longitude,
 	})
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.Status(400).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
 
-	return c.Status(201).JSON(resp.Address)
+	return c.Status(201).JSON(addressJSON(resp.Address))
 }
 
 func (ac *AddressController) Update(c *fiber.Ctx) error {
@@ -129,10 +136,7 @@ func (ac *AddressController) Update(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
 	}
 
-	var body struct {
-		Name string `json:"name"`
-		Desc string `json:"desc"`
-	}
+	var body addressInput
 	if err := c.BodyParser(&body); err != nil {
 		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
 	}
@@ -141,15 +145,105 @@ func (ac *AddressController) Update(c *fiber.Ctx) error {
 	defer cancel()
 
 	resp, err := ac.Client.UpdateAddress(ctx, &pb.UpdateAddressRequest{
-		Id:   uint32(id),
-		Name: body.Name,
-		Desc: body.Desc,
+		Id:          uint32(id),
+		OwnerId:     c.Locals("user_id").(uint32),
+		Name:        body.Name,
+		Desc:        body.Desc,
+		Recipient:   body.Recipient,
+		Phone:       body.Phone,
+		StreetLine1: body.StreetLine1,
+		StreetLine2: body.StreetLine2,
+		City:        body.City,
+		Province:    body.Province,
+		PostalCode:  body.PostalCode,
+		CountryCode: body.CountryCode,
+		Latitude:    body.Latitude,
+		Longitude:   body.Longitude,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return c.Status(400).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		case codes.NotFound:
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(addressJSON(resp.Address))
+}
+
+// SetDefault menjadikan alamat :id default, body {"type": "shipping" | "billing" | "both"} (default both).
+func (ac *AddressController) SetDefault(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var body struct {
+		Type string `json:"type"`
+	}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&body); err != nil {
+			return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := ac.Client.SetDefaultAddress(ctx, &pb.SetDefaultAddressRequest{
+		Id:      uint32(id),
+		OwnerId: c.Locals("user_id").(uint32),
+		Type:    body.Type,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return c.Status(400).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		case codes.PermissionDenied:
+			return c.Status(403).JSON(fiber.Map{"error": "you are not the owner of this address"})
+		case codes.NotFound:
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(addressJSON(resp.Address))
+}
+
+// Serviceability memberi tahu apakah kami bisa mengirim ke alamat :id.
+func (ac *AddressController) Serviceability(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := ac.Client.CheckServiceability(ctx, &pb.CheckServiceabilityRequest{
+		AddressId: uint32(id),
+		OwnerId:   c.Locals("user_id").(uint32),
 	})
 	if err != nil {
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			return c.Status(403).JSON(fiber.Map{"error": "you are not the owner of this address"})
+		case codes.NotFound:
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		case codes.Unavailable:
+			return c.Status(503).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
 
-	return c.JSON(resp.Address)
+	out := fiber.Map{"serviceable": resp.Serviceable, "reason": resp.Reason}
+	if resp.Latitude != nil && resp.Longitude != nil {
+		out["latitude"] = *resp.Latitude
+		out["longitude"] = *resp.Longitude
+	}
+	return c.JSON(out)
 }
 
 func (ac *AddressController) Delete(c *fiber.Ctx) error {
@@ -161,14 +255,53 @@ func (ac *AddressController) Delete(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	resp, err := ac.Client.DeleteAddress(ctx, &pb.DeleteAddressRequest{Id: uint32(id)})
+	resp, err := ac.Client.DeleteAddress(ctx, &pb.DeleteAddressRequest{
+		Id:      uint32(id),
+		OwnerId: c.Locals("user_id").(uint32),
+	})
 	if err != nil {
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			return c.Status(403).JSON(fiber.Map{"error": "you are not the owner of this address"})
+		case codes.NotFound:
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
 
 	return c.JSON(resp)
 }
 
+// GetVersion dipakai support untuk melihat alamat :id versi :version milik siapa pun,
+// mis. alamat yang tercatat saat sebuah order dibuat walau alamatnya sudah dihapus.
+func (ac *AddressController) GetVersion(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+	version, err := strconv.Atoi(c.Params("version"))
+	if err != nil || version < 1 {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid version"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := ac.Client.GetAddress(ctx, &pb.GetAddressRequest{
+		Id:       uint32(id),
+		Version:  uint32(version),
+		AnyOwner: true,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(addressJSON(resp.Address))
+}
+
 func (ac *AddressController) GetAllAddresses(c *fiber.Ctx) error {
     ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
     defer cancel()
@@ -182,14 +315,7 @@ func (ac *AddressController) GetAllAddresses(c *fiber.Ctx) error {
     }
 
     // Struct untuk response JSON
-    type AddressResponse struct {
-        ID         uint32 `json:"id"`
-        Name       string `json:"name"`
-        Desc       string `json:"desc"`
-        OwnerEmail string `json:"owner_email"`
-    }
-
-    var out []AddressResponse
+    var out []fiber.Map
 
     // Loop semua address
     for _, addr := range resp.Addresses {
@@ -198,28 +324,71 @@ func (ac *AddressController) GetAllAddresses(c *fiber.Ctx) error {
         if err != nil {
             // Kalau error, bisa lanjut tapi kasih tanda error di email-nya
             fmt.Printf("failed to get email for owner_id=%d: %v\n", addr.OwnerId, err)
-            out = append(out, AddressResponse{
-                ID:         addr.Id,
-                Name:       addr.Name,
-                Desc:       addr.Desc,
-                OwnerEmail: fmt.Sprintf("error: %v", err),
-            })
+            m := addressJSON(addr)
+            m["owner_email"] = fmt.Sprintf("error: %v", err)
+            out = append(out, m)
             continue
         }
 
         // Tambahkan hasil ke response
-        out = append(out, AddressResponse{
-            ID:         addr.Id,
-            Name:       addr.Name,
-            Desc:       addr.Desc,
-            OwnerEmail: userResp.Email,
-        })
+        m := addressJSON(addr)
+        m["owner_email"] = userResp.Email
+        out = append(out, m)
     }
 
     return c.JSON(out)
 }
+// addressInput adalah body create / update. name = label, desc = catatan untuk kurir.
+type addressInput struct {
+	Name        string   `json:"name"`
+	Desc        string   `json:"desc"`
+	Recipient   string   `json:"recipient"`
+	Phone       string   `json:"phone"`
+	StreetLine1 string   `json:"street_line1"`
+	StreetLine2 string   `json:"street_line2"`
+	City        string   `json:"city"`
+	Province    string   `json:"province"`
+	PostalCode  string   `json:"postal_code"`
+	CountryCode string   `json:"country_code"`
+	Latitude    *float64 `json:"latitude"`
+	Longitude   *float64 `json:"longitude"`
+}
+
+func addressJSON(a *pb.Address) fiber.Map {
+	m := fiber.Map{
+		"id":           a.Id,
+		"name":         a.Name,
+		"desc":         a.Desc,
+		"owner_id":     a.OwnerId,
+		"recipient":    a.Recipient,
+		"phone":        a.Phone,
+		"street_line1": a.StreetLine1,
+		"street_line2": a.StreetLine2,
+		"city":         a.City,
+		"province":     a.Province,
+		"postal_code":  a.PostalCode,
+		"country_code": a.CountryCode,
+		"created_at":   a.CreatedAt,
+
+		"is_default_shipping": a.IsDefaultShipping,
+		"is_default_billing":  a.IsDefaultBilling,
+		"version":             a.Version,
+	}
+	if a.DeletedAt != "" {
+		m["deleted_at"] = a.DeletedAt
+	}
+	if a.VersionCreatedAt != "" {
+		m["version_created_at"] = a.VersionCreatedAt
+	}
+	if a.Latitude != nil && a.Longitude != nil {
+		m["latitude"] = *a.Latitude
+		m["longitude"] = *a.Longitude
+	}
+	return m
+}
+
 func NewAddressController() *AddressController {
-	addrConn, err := grpc.Dial("localhost:50053", grpc.WithInsecure())
+	addrConn, err := grpc.Dial("localhost:50053", grpc.WithInsecure(), svcauth.DialOption())
 	if err != nil {
 		panic("failed to connect to address gRPC: " + err.Error())
 	}
@@ -0,0 +1,72 @@
+// Package coverage menentukan apakah kami mengirim ke sebuah alamat.
+package coverage
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"address-service/geocode"
+)
+
+// Checker: alamat serviceable kalau negaranya dilayani, kode posnya tidak diblokir,
+// dan lokasinya bisa ditemukan geocoder.
+type Checker struct {
+	Geocoder              geocode.Geocoder
+	Countries             map[string]bool
+	BlockedPostalPrefixes []string
+}
+
+// Result adalah hasil pengecekan; Reason kosong kalau serviceable.
+type Result struct {
+	Serviceable bool
+	Reason      string
+	Latitude    *float64
+	Longitude   *float64
+}
+
+// NewChecker membuat Checker dari daftar dipisah koma (mis. env SERVICEABLE_COUNTRIES).
+func NewChecker(g geocode.Geocoder, countries, blockedPrefixes string) *Checker {
+	c := &Checker{Geocoder: g, Countries: map[string]bool{}}
+	for _, cc := range strings.Split(countries, ",") {
+		if cc = strings.ToUpper(strings.TrimSpace(cc)); cc != "" {
+			c.Countries[cc] = true
+		}
+	}
+	for _, p := range strings.Split(blockedPrefixes, ",") {
+		if p = strings.ToUpper(strings.TrimSpace(p)); p != "" {
+			c.BlockedPostalPrefixes = append(c.BlockedPostalPrefixes, p)
+		}
+	}
+	return c
+}
+
+// Check mengembalikan error hanya kalau geocoder gagal dihubungi; alamat yang
+// tidak ditemukan dianggap tidak serviceable.
+func (c *Checker) Check(ctx context.Context, q geocode.Query, lat, lng *float64) (*Result, error) {
+	if !c.Countries[strings.ToUpper(q.CountryCode)] {
+		return &Result{Reason: "we do not deliver to this country"}, nil
+	}
+
+	postal := strings.ToUpper(strings.ReplaceAll(q.PostalCode, " ", ""))
+	for _, p := range c.BlockedPostalPrefixes {
+		if strings.HasPrefix(postal, p) {
+			return &Result{Reason: "we do not deliver to this postal code"}, nil
+		}
+	}
+
+	// wilayah yang tidak dikenal geocoder dianggap di luar jangkauan, walaupun
+	// user sudah mengirim koordinatnya sendiri
+	loc, err := c.Geocoder.Geocode(ctx, q)
+	if errors.Is(err, geocode.ErrNotFound) {
+		return &Result{Reason: "address could not be located"}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if lat == nil || lng == nil {
+		lat, lng = &loc.Latitude, &loc.Longitude
+	}
+	return &Result{Serviceable: true, Latitude: lat, Longitude: lng}, nil
+}
@@ -0,0 +1,35 @@
+// Package geocode mengubah alamat terstruktur menjadi koordinat. Implementasi
+// dipilih lewat env GEOCODER: "table" (offline, tabel kode pos) atau "http"
+// (provider eksternal / stub lokal dengan kontrak yang sama).
+package geocode
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound dikembalikan kalau alamat tidak bisa ditemukan provider.
+var ErrNotFound = errors.New("geocode: address not found")
+
+// Query adalah bagian alamat yang dikirim ke geocoder.
+type Query struct {
+	StreetLine1 string
+	City        string
+	Province    string
+	PostalCode  string
+	CountryCode string
+}
+
+// Result adalah koordinat hasil geocoding.
+type Result struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Precision mis. "street", "postal_code" atau "postal_prefix"
+	Precision string `json:"precision"`
+	Source    string `json:"source"`
+}
+
+// Geocoder diimplementasikan PostalTable dan HTTPGeocoder.
+type Geocoder interface {
+	Geocode(ctx context.Context, q Query) (*Result, error)
+}
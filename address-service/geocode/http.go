@@ -0,0 +1,73 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// HTTPGeocoder memanggil provider geocoding lewat HTTP:
+//
+//	GET {BaseURL}/geocode?country=ID&postal_code=10220&city=...&province=...&street=...
+//	200 {"latitude": -6.2, "longitude": 106.8, "precision": "street"}
+//	404 kalau alamat tidak ditemukan
+//
+// Provider sungguhan dipasang di belakang adapter dengan kontrak ini, dan untuk
+// development BaseURL bisa diarahkan ke stub lokal.
+type HTTPGeocoder struct {
+	BaseURL string
+	APIKey  string
+	Client  *http.Client
+}
+
+func NewHTTPGeocoder(baseURL, apiKey string, timeout time.Duration) *HTTPGeocoder {
+	return &HTTPGeocoder{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		APIKey:  apiKey,
+		Client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (g *HTTPGeocoder) Geocode(ctx context.Context, q Query) (*Result, error) {
+	params := url.Values{}
+	params.Set("country", q.CountryCode)
+	params.Set("postal_code", q.PostalCode)
+	params.Set("city", q.City)
+	params.Set("province", q.Province)
+	params.Set("street", q.StreetLine1)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.BaseURL+"/geocode?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if g.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+g.APIKey)
+	}
+
+	resp, err := g.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("geocode request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("geocode provider returned %s", resp.Status)
+	}
+
+	var res Result
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("invalid geocode response: %w", err)
+	}
+	if res.Latitude < -90 || res.Latitude > 90 || res.Longitude < -180 || res.Longitude > 180 {
+		return nil, fmt.Errorf("geocode provider returned invalid coordinates")
+	}
+	res.Source = "http"
+	return &res, nil
+}
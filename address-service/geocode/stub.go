@@ -0,0 +1,38 @@
+package geocode
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// StubHandler melayani kontrak HTTPGeocoder memakai geocoder lain (biasanya PostalTable),
+// untuk development / test lokal tanpa provider sungguhan.
+func StubHandler(g Geocoder) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/geocode" {
+			http.NotFound(w, r)
+			return
+		}
+
+		q := r.URL.Query()
+		res, err := g.Geocode(r.Context(), Query{
+			StreetLine1: q.Get("street"),
+			City:        q.Get("city"),
+			Province:    q.Get("province"),
+			PostalCode:  q.Get("postal_code"),
+			CountryCode: q.Get("country"),
+		})
+		if errors.Is(err, ErrNotFound) {
+			http.Error(w, `{"error":"not found"}`, http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, `{"error":"geocoding failed"}`, http.StatusBadGateway)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	})
+}
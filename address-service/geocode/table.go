@@ -0,0 +1,97 @@
+package geocode
+
+import (
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+//go:embed data/postal_codes.csv
+var defaultTable string
+
+type tableEntry struct {
+	prefix string
+	lat    float64
+	lng    float64
+}
+
+// PostalTable adalah geocoder offline berbasis tabel kode pos (atau awalannya).
+// Koordinat yang dihasilkan adalah titik tengah wilayah, bukan lokasi rumah.
+type PostalTable struct {
+	entries map[string][]tableEntry // per country code
+}
+
+// DefaultPostalTable memuat tabel bawaan yang di-embed di binary.
+func DefaultPostalTable() *PostalTable {
+	t, err := LoadPostalTable(strings.NewReader(defaultTable))
+	if err != nil {
+		panic("geocode: invalid embedded postal table: " + err.Error())
+	}
+	return t
+}
+
+// LoadPostalTable membaca CSV dengan header
+// country_code,postal_prefix,latitude,longitude[,keterangan].
+func LoadPostalTable(r io.Reader) (*PostalTable, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	t := &PostalTable{entries: map[string][]tableEntry{}}
+	for i, row := range rows {
+		if i == 0 && row[0] == "country_code" {
+			continue
+		}
+		if len(row) < 4 {
+			return nil, fmt.Errorf("line %d: expected at least 4 columns", i+1)
+		}
+		lat, err := strconv.ParseFloat(strings.TrimSpace(row[2]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid latitude: %v", i+1, err)
+		}
+		lng, err := strconv.ParseFloat(strings.TrimSpace(row[3]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid longitude: %v", i+1, err)
+		}
+		country := strings.ToUpper(strings.TrimSpace(row[0]))
+		t.entries[country] = append(t.entries[country], tableEntry{
+			prefix: strings.ToUpper(strings.TrimSpace(row[1])),
+			lat:    lat,
+			lng:    lng,
+		})
+	}
+	return t, nil
+}
+
+// Geocode mencari entri dengan awalan kode pos terpanjang yang cocok.
+func (t *PostalTable) Geocode(ctx context.Context, q Query) (*Result, error) {
+	postal := strings.ToUpper(strings.ReplaceAll(q.PostalCode, " ", ""))
+	if postal == "" {
+		return nil, ErrNotFound
+	}
+
+	var best *tableEntry
+	for i, e := range t.entries[strings.ToUpper(q.CountryCode)] {
+		if strings.HasPrefix(postal, e.prefix) && (best == nil || len(e.prefix) > len(best.prefix)) {
+			best = &t.entries[strings.ToUpper(q.CountryCode)][i]
+		}
+	}
+	if best == nil {
+		return nil, ErrNotFound
+	}
+
+	precision := "postal_prefix"
+	if best.prefix == postal {
+		precision = "postal_code"
+	}
+	return &Result{Latitude: best.lat, Longitude: best.lng, Precision: precision, Source: "postal_table"}, nil
+}
@@ -0,0 +1,121 @@
+package grpc_server
+
+import (
+	"time"
+
+	"address-service/model"
+	pb "address-service/proto/address"
+	"address-service/validation"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// kolom yang di-SELECT / RETURNING, urutannya harus sama dengan scanAddress
+const addressColumns = `id, name, "desc", owner_id, created_at,
+    recipient, phone, street_line1, street_line2, city, province, postal_code, country_code, latitude, longitude,
+    is_default_shipping, is_default_billing, version, deleted_at`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAddress(row rowScanner, a *model.Address) error {
+	return row.Scan(&a.ID, &a.Name, &a.Desc, &a.OwnerID, &a.CreatedAt,
+		&a.Recipient, &a.Phone, &a.StreetLine1, &a.StreetLine2, &a.City, &a.Province,
+		&a.PostalCode, &a.CountryCode, &a.Latitude, &a.Longitude,
+		&a.IsDefaultShipping, &a.IsDefaultBilling, &a.Version, &a.DeletedAt)
+}
+
+// validateAddress menormalisasi dan memvalidasi field terstruktur sesuai aturan negaranya.
+func validateAddress(f *validation.Fields) error {
+	if err := validation.Validate(f); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	return nil
+}
+
+func createFields(req *pb.CreateAddressRequest) *validation.Fields {
+	return &validation.Fields{
+		Recipient:   req.Recipient,
+		Phone:       req.Phone,
+		StreetLine1: req.StreetLine1,
+		StreetLine2: req.StreetLine2,
+		City:        req.City,
+		Province:    req.Province,
+		PostalCode:  req.PostalCode,
+		CountryCode: req.CountryCode,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+	}
+}
+
+func updateFields(req *pb.UpdateAddressRequest) *validation.Fields {
+	return &validation.Fields{
+		Recipient:   req.Recipient,
+		Phone:       req.Phone,
+		StreetLine1: req.StreetLine1,
+		StreetLine2: req.StreetLine2,
+		City:        req.City,
+		Province:    req.Province,
+		PostalCode:  req.PostalCode,
+		CountryCode: req.CountryCode,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+	}
+}
+
+func toProtoAddress(a *model.Address) *pb.Address {
+	out := &pb.Address{
+		Id:          uint32(a.ID),
+		Name:        a.Name,
+		Desc:        a.Desc,
+		OwnerId:     uint32(a.OwnerID),
+		CreatedAt:   a.CreatedAt.Format(time.RFC3339),
+		Recipient:   a.Recipient,
+		Phone:       a.Phone,
+		StreetLine1: a.StreetLine1,
+		StreetLine2: a.StreetLine2,
+		City:        a.City,
+		Province:    a.Province,
+		PostalCode:  a.PostalCode,
+		CountryCode: a.CountryCode,
+		Latitude:    a.Latitude,
+		Longitude:   a.Longitude,
+
+		IsDefaultShipping: a.IsDefaultShipping,
+		IsDefaultBilling:  a.IsDefaultBilling,
+		Version:           uint32(a.Version),
+	}
+	if a.DeletedAt != nil {
+		out.DeletedAt = a.DeletedAt.Format(time.RFC3339)
+	}
+	return out
+}
+
+// addressEventData adalah payload address_created / address_updated (diindeks search-service).
+func addressEventData(a *model.Address) map[string]interface{} {
+	data := map[string]interface{}{
+		"id":           a.ID,
+		"name":         a.Name,
+		"desc":         a.Desc,
+		"owner_id":     a.OwnerID,
+		"recipient":    a.Recipient,
+		"phone":        a.Phone,
+		"street_line1": a.StreetLine1,
+		"street_line2": a.StreetLine2,
+		"city":         a.City,
+		"province":     a.Province,
+		"postal_code":  a.PostalCode,
+		"country_code": a.CountryCode,
+
+		"is_default_shipping": a.IsDefaultShipping,
+		"is_default_billing":  a.IsDefaultBilling,
+		"version":             a.Version,
+	}
+	if a.Latitude != nil && a.Longitude != nil {
+		data["latitude"] = *a.Latitude
+		data["longitude"] = *a.Longitude
+	}
+	return data
+}
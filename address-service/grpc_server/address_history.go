@@ -0,0 +1,68 @@
+package grpc_server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"address-service/model"
+	pb "address-service/proto/address"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// kolom isi alamat yang disalin ke address_versions
+const versionedColumns = `name, "desc", recipient, phone, street_line1, street_line2, city, province,
+    postal_code, country_code, latitude, longitude`
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// recordVersion menyalin isi alamat saat ini sebagai versi barunya. Dipanggil dalam
+// transaksi yang sama dengan INSERT / UPDATE alamatnya.
+func recordVersion(ctx context.Context, db execer, addressID uint) error {
+	_, err := db.ExecContext(ctx, `INSERT INTO address_versions (address_id, version, owner_id, `+versionedColumns+`, created_at)
+        SELECT id, version, owner_id, `+versionedColumns+`, NOW() FROM addresses WHERE id=$1`, addressID)
+	return err
+}
+
+// BackfillAddressHistory mencatat versi saat ini untuk alamat yang belum punya history
+// (dibuat sebelum address_versions ada).
+func BackfillAddressHistory(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `INSERT INTO address_versions (address_id, version, owner_id, `+versionedColumns+`, created_at)
+        SELECT a.id, a.version, a.owner_id, `+versionedColumns+`, a.created_at FROM addresses a
+        WHERE NOT EXISTS (SELECT 1 FROM address_versions v WHERE v.address_id = a.id AND v.version = a.version)`)
+	return err
+}
+
+// getAddressVersion mengambil isi alamat pada versi tertentu, termasuk alamat yang sudah dihapus.
+func (s *AddressServer) getAddressVersion(ctx context.Context, req *pb.GetAddressRequest) (*pb.AddressResponse, error) {
+	var (
+		a         model.Address
+		versionAt time.Time
+	)
+	err := s.DB.QueryRowContext(ctx, `SELECT v.address_id, v.name, v."desc", v.owner_id, a.created_at,
+            v.recipient, v.phone, v.street_line1, v.street_line2, v.city, v.province, v.postal_code, v.country_code,
+            v.latitude, v.longitude, v.version, a.deleted_at, v.created_at
+        FROM address_versions v JOIN addresses a ON a.id = v.address_id
+        WHERE v.address_id=$1 AND v.version=$2`, req.Id, req.Version).
+		Scan(&a.ID, &a.Name, &a.Desc, &a.OwnerID, &a.CreatedAt,
+			&a.Recipient, &a.Phone, &a.StreetLine1, &a.StreetLine2, &a.City, &a.Province, &a.PostalCode, &a.CountryCode,
+			&a.Latitude, &a.Longitude, &a.Version, &a.DeletedAt, &versionAt)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "address version not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	if !req.AnyOwner && a.OwnerID != uint(req.OwnerId) {
+		return nil, status.Errorf(codes.PermissionDenied, "unauthorized")
+	}
+
+	out := toProtoAddress(&a)
+	out.VersionCreatedAt = versionAt.Format(time.RFC3339)
+	return &pb.AddressResponse{Address: out}, nil
+}
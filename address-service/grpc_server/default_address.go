@@ -0,0 +1,125 @@
+package grpc_server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"address-service/model"
+	pb "address-service/proto/address"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// kolom flag untuk tiap jenis alamat default
+var defaultColumns = map[string][]string{
+	"shipping": {"is_default_shipping"},
+	"billing":  {"is_default_billing"},
+	"both":     {"is_default_shipping", "is_default_billing"},
+}
+
+// SetDefaultAddress menjadikan alamat default shipping / billing milik owner-nya.
+// Default lama dilepas dalam transaksi yang sama.
+func (s *AddressServer) SetDefaultAddress(ctx context.Context, req *pb.SetDefaultAddressRequest) (*pb.AddressResponse, error) {
+	kind := req.Type
+	if kind == "" {
+		kind = "both"
+	}
+	cols, ok := defaultColumns[kind]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "type must be shipping, billing or both")
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	// kunci semua alamat owner supaya dua request bersamaan tidak bentrok di unique index
+	if _, err := tx.ExecContext(ctx, `SELECT id FROM addresses WHERE owner_id=$1 AND deleted_at IS NULL FOR UPDATE`, req.OwnerId); err != nil {
+		return nil, status.Errorf(codes.Internal, "lock error: %v", err)
+	}
+
+	var ownerID uint32
+	err = tx.QueryRowContext(ctx, `SELECT owner_id FROM addresses WHERE id=$1 AND deleted_at IS NULL`, req.Id).Scan(&ownerID)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "address not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	if ownerID != req.OwnerId {
+		return nil, status.Errorf(codes.PermissionDenied, "unauthorized")
+	}
+
+	// alamat yang flag-nya berubah, untuk event address_updated
+	changed := map[uint32]bool{req.Id: true}
+	for _, col := range cols {
+		rows, err := tx.QueryContext(ctx,
+			fmt.Sprintf(`UPDATE addresses SET %[1]s=false WHERE owner_id=$1 AND %[1]s AND id<>$2 RETURNING id`, col),
+			ownerID, req.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "update error: %v", err)
+		}
+		for rows.Next() {
+			var id uint32
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, status.Errorf(codes.Internal, "scan error: %v", err)
+			}
+			changed[id] = true
+		}
+		rows.Close()
+
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE addresses SET %s=true WHERE id=$1`, col), req.Id); err != nil {
+			return nil, status.Errorf(codes.Internal, "update error: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit error: %v", err)
+	}
+
+	// cache list dihapus setelah commit supaya tidak terisi ulang dengan data sebelum perubahan
+	s.Redis.Del(ctx, fmt.Sprintf("addresses:%d", ownerID))
+
+	var target model.Address
+	for id := range changed {
+		var a model.Address
+		err := scanAddress(s.DB.QueryRowContext(ctx, `SELECT `+addressColumns+` FROM addresses WHERE id=$1`, id), &a)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "query error: %v", err)
+		}
+		s.Producer.PublishAddressUpdatedEvent(map[string]interface{}{
+			"event_type": "address_updated",
+			"data":       addressEventData(&a),
+		})
+		if id == req.Id {
+			target = a
+		}
+	}
+
+	return &pb.AddressResponse{Address: toProtoAddress(&target)}, nil
+}
+
+// GetDefaultAddress mengembalikan alamat default shipping / billing milik owner.
+func (s *AddressServer) GetDefaultAddress(ctx context.Context, req *pb.GetDefaultAddressRequest) (*pb.AddressResponse, error) {
+	cols, ok := defaultColumns[req.Type]
+	if !ok || len(cols) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "type must be shipping or billing")
+	}
+
+	var a model.Address
+	err := scanAddress(s.DB.QueryRowContext(ctx,
+		fmt.Sprintf(`SELECT %s FROM addresses WHERE owner_id=$1 AND %s AND deleted_at IS NULL`, addressColumns, cols[0]), req.OwnerId), &a)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "no default %s address", req.Type)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	return &pb.AddressResponse{Address: toProtoAddress(&a)}, nil
+}
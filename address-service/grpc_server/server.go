@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"time"
 
+	"address-service/coverage"
+	"address-service/geocode"
 	kafka "address-service/kafka"
 	"address-service/model"
 	pb "address-service/proto/address"
@@ -22,19 +24,44 @@ type AddressServer struct {
     DB       *sql.DB
     Producer *kafka.Producer
     Redis    *redis.Client
+    // koordinat diisi otomatis kalau client tidak mengirimnya
+    Geocoder geocode.Geocoder
+    Coverage *coverage.Checker
 }
 
 // CREATE
 
 func (s *AddressServer) CreateAddress(ctx context.Context, req *pb.CreateAddressRequest) (*pb.AddressResponse, error) {
-    query := `INSERT INTO addresses (name, "desc", owner_id, created_at)
-              VALUES ($1, $2, $3, NOW()) RETURNING id`
+    f := createFields(req)
+    if err := validateAddress(f); err != nil {
+        return nil, err
+    }
+    s.fillCoordinates(ctx, f)
+
+    query := `INSERT INTO addresses (name, "desc", owner_id, created_at,
+                  recipient, phone, street_line1, street_line2, city, province, postal_code, country_code, latitude, longitude)
+              VALUES ($1, $2, $3, NOW(), $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+              RETURNING ` + addressColumns
 
-    var id uint32
-    err := s.DB.QueryRowContext(ctx, query, req.Name, req.Desc, req.OwnerId).Scan(&id)
+    tx, err := s.DB.BeginTx(ctx, nil)
+    if err != nil {
+        return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+    }
+    defer tx.Rollback()
+
+    var a model.Address
+    err = scanAddress(tx.QueryRowContext(ctx, query, req.Name, req.Desc, req.OwnerId,
+        f.Recipient, f.Phone, f.StreetLine1, f.StreetLine2, f.City, f.Province, f.PostalCode, f.CountryCode,
+        f.Latitude, f.Longitude), &a)
     if err != nil {
         return nil, status.Errorf(codes.Internal, "failed to insert: %v", err)
     }
+    if err := recordVersion(ctx, tx, a.ID); err != nil {
+        return nil, status.Errorf(codes.Internal, "failed to record history: %v", err)
+    }
+    if err := tx.Commit(); err != nil {
+        return nil, status.Errorf(codes.Internal, "commit error: %v", err)
+    }
 
     // Hapus cache LIST user ini
     cacheKey := fmt.Sprintf("addresses:%d", req.OwnerId)
@@ -43,34 +70,25 @@ func (s *AddressServer) CreateAddress(ctx context.Context, req *pb.CreateAddress
     // Event Kafka
     event := map[string]interface{}{
         "event_type": "address_created",
-        "data": map[string]interface{}{
-            "id":       id,
-            "name":     req.Name,
-            "desc":     req.Desc,
-            "owner_id": req.OwnerId,
-        },
+        "data":       addressEventData(&a),
     }
     s.Producer.PublishAddressCreatedEvent(event)
 
-    return &pb.AddressResponse{
-        Address: &pb.Address{
-            Id:      id,
-            Name:    req.Name,
-            Desc:    req.Desc,
-            OwnerId: req.OwnerId,
-        },
-    }, nil
+    return &pb.AddressResponse{Address: toProtoAddress(&a)}, nil
 }
 
 // GET SINGLE
 
 func (s *AddressServer) GetAddress(ctx context.Context, req *pb.GetAddressRequest) (*pb.AddressResponse, error) {
-    query := `SELECT id, name, "desc", owner_id, created_at
-              FROM addresses WHERE id = $1`
+    if req.Version > 0 {
+        return s.getAddressVersion(ctx, req)
+    }
+
+    query := `SELECT ` + addressColumns + `
+              FROM addresses WHERE id = $1 AND deleted_at IS NULL`
 
     var a model.Address
-    err := s.DB.QueryRowContext(ctx, query, req.Id).
-        Scan(&a.ID, &a.Name, &a.Desc, &a.OwnerID, &a.CreatedAt)
+    err := scanAddress(s.DB.QueryRowContext(ctx, query, req.Id), &a)
 
     if err == sql.ErrNoRows {
         return nil, status.Errorf(codes.NotFound, "address not found")
@@ -79,19 +97,11 @@ func (s *AddressServer) GetAddress(ctx context.Context, req *pb.GetAddressReques
         return nil, status.Errorf(codes.Internal, "query error: %v", err)
     }
 
-    if a.OwnerID != uint(req.OwnerId) {
+    if !req.AnyOwner && a.OwnerID != uint(req.OwnerId) {
         return nil, status.Errorf(codes.PermissionDenied, "unauthorized")
     }
 
-    return &pb.AddressResponse{
-        Address: &pb.Address{
-            Id:        uint32(a.ID),
-            Name:      a.Name,
-            Desc:      a.Desc,
-            OwnerId:   uint32(a.OwnerID),
-            CreatedAt: a.CreatedAt.Format(time.RFC3339),
-        },
-    }, nil
+    return &pb.AddressResponse{Address: toProtoAddress(&a)}, nil
 }
 
 // LIST (WITH REDIS CACHE)
@@ -114,8 +124,8 @@ func (s *AddressServer) ListAddresses(ctx context.Context, req *pb.ListAddressRe
         fmt.Println("Redis MISS → DB query")
     }
 
-    query := `SELECT id, name, "desc", owner_id, created_at
-              FROM addresses WHERE owner_id = $1`
+    query := `SELECT ` + addressColumns + `
+              FROM addresses WHERE owner_id = $1 AND deleted_at IS NULL`
 
     rows, err := s.DB.QueryContext(ctx, query, req.OwnerId)
     if err != nil {
@@ -126,16 +136,10 @@ func (s *AddressServer) ListAddresses(ctx context.Context, req *pb.ListAddressRe
     var addresses []*pb.Address
     for rows.Next() {
         var a model.Address
-        if err := rows.Scan(&a.ID, &a.Name, &a.Desc, &a.OwnerID, &a.CreatedAt); err != nil {
+        if err := scanAddress(rows, &a); err != nil {
             return nil, status.Errorf(codes.Internal, "scan error: %v", err)
         }
-        addresses = append(addresses, &pb.Address{
-            Id:        uint32(a.ID),
-            Name:      a.Name,
-            Desc:      a.Desc,
-            OwnerId:   uint32(a.OwnerID),
-            CreatedAt: a.CreatedAt.Format(time.RFC3339),
-        })
+        addresses = append(addresses, toProtoAddress(&a))
     }
 
     // save redis
@@ -150,12 +154,28 @@ func (s *AddressServer) ListAddresses(ctx context.Context, req *pb.ListAddressRe
 //  UPDATE
 
 func (s *AddressServer) UpdateAddress(ctx context.Context, req *pb.UpdateAddressRequest) (*pb.AddressResponse, error) {
-    query := `UPDATE addresses SET name=$1, "desc"=$2
-              WHERE id=$3 RETURNING id, name, "desc", owner_id, created_at`
+    f := updateFields(req)
+    if err := validateAddress(f); err != nil {
+        return nil, err
+    }
+    s.fillCoordinates(ctx, f)
+
+    // owner_id ikut di WHERE supaya user tidak bisa mengubah alamat milik orang lain
+    query := `UPDATE addresses SET name=$1, "desc"=$2,
+                  recipient=$3, phone=$4, street_line1=$5, street_line2=$6, city=$7, province=$8,
+                  postal_code=$9, country_code=$10, latitude=$11, longitude=$12, version=version+1
+              WHERE id=$13 AND owner_id=$14 AND deleted_at IS NULL RETURNING ` + addressColumns
+
+    tx, err := s.DB.BeginTx(ctx, nil)
+    if err != nil {
+        return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+    }
+    defer tx.Rollback()
 
     var a model.Address
-    err := s.DB.QueryRowContext(ctx, query, req.Name, req.Desc, req.Id).
-        Scan(&a.ID, &a.Name, &a.Desc, &a.OwnerID, &a.CreatedAt)
+    err = scanAddress(tx.QueryRowContext(ctx, query, req.Name, req.Desc,
+        f.Recipient, f.Phone, f.StreetLine1, f.StreetLine2, f.City, f.Province,
+        f.PostalCode, f.CountryCode, f.Latitude, f.Longitude, req.Id, req.OwnerId), &a)
 
     if err == sql.ErrNoRows {
         return nil, status.Errorf(codes.NotFound, "address not found")
@@ -163,6 +183,12 @@ func (s *AddressServer) UpdateAddress(ctx context.Context, req *pb.UpdateAddress
     if err != nil {
         return nil, status.Errorf(codes.Internal, "update error: %v", err)
     }
+    if err := recordVersion(ctx, tx, a.ID); err != nil {
+        return nil, status.Errorf(codes.Internal, "failed to record history: %v", err)
+    }
+    if err := tx.Commit(); err != nil {
+        return nil, status.Errorf(codes.Internal, "commit error: %v", err)
+    }
 
     // DELETE CACHE
     cacheKey := fmt.Sprintf("addresses:%d", a.OwnerID)
@@ -170,24 +196,11 @@ func (s *AddressServer) UpdateAddress(ctx context.Context, req *pb.UpdateAddress
 
     event := map[string]interface{}{
         "event_type": "address_updated",
-        "data": map[string]interface{}{
-            "id":       a.ID,
-            "name":     a.Name,
-            "desc":     a.Desc,
-            "owner_id": a.OwnerID,
-        },
+        "data":       addressEventData(&a),
     }
     s.Producer.PublishAddressUpdatedEvent(event)
 
-    return &pb.AddressResponse{
-        Address: &pb.Address{
-            Id:        uint32(a.ID),
-            Name:      a.Name,
-            Desc:      a.Desc,
-            OwnerId:   uint32(a.OwnerID),
-            CreatedAt: a.CreatedAt.Format(time.RFC3339),
-        },
-    }, nil
+    return &pb.AddressResponse{Address: toProtoAddress(&a)}, nil
 }
 
 //  DELETE
@@ -196,7 +209,7 @@ func (s *AddressServer) DeleteAddress(ctx context.Context, req *pb.DeleteAddress
     // get owner id
     var ownerID uint32
     err := s.DB.QueryRowContext(ctx,
-        `SELECT owner_id FROM addresses WHERE id=$1`, req.Id).
+        `SELECT owner_id FROM addresses WHERE id=$1 AND deleted_at IS NULL`, req.Id).
         Scan(&ownerID)
 
     if err == sql.ErrNoRows {
@@ -211,9 +224,11 @@ func (s *AddressServer) DeleteAddress(ctx context.Context, req *pb.DeleteAddress
         return nil, status.Errorf(codes.PermissionDenied, "unauthorized")
     }
 
-    // 3. Delete
+    // 3. Soft delete; baris tetap ada karena transaksi lama merujuk ke versinya.
+    // Flag default dilepas supaya CreateTransaction tidak jatuh ke alamat yang sudah dihapus.
     _, err = s.DB.ExecContext(ctx,
-        `DELETE FROM addresses WHERE id=$1`, req.Id)
+        `UPDATE addresses SET deleted_at=NOW(), is_default_shipping=false, is_default_billing=false
+         WHERE id=$1 AND deleted_at IS NULL`, req.Id)
     if err != nil {
         return nil, status.Errorf(codes.Internal, "delete error: %v", err)
     }
@@ -240,7 +255,7 @@ func (s *AddressServer) DeleteAddress(ctx context.Context, req *pb.DeleteAddress
 
 
 func (s *AddressServer) GetAllAddresses(ctx context.Context, _ *emptypb.Empty) (*pb.GetAllAddressesResponse, error) {
-    query := `SELECT id, name, "desc", owner_id, created_at FROM addresses`
+    query := `SELECT ` + addressColumns + ` FROM addresses WHERE deleted_at IS NULL`
 
     rows, err := s.DB.QueryContext(ctx, query)
     if err != nil {
@@ -251,16 +266,10 @@ func (s *AddressServer) GetAllAddresses(ctx context.Context, _ *emptypb.Empty) (
     var addresses []*pb.Address
     for rows.Next() {
         var a model.Address
-        if err := rows.Scan(&a.ID, &a.Name, &a.Desc, &a.OwnerID, &a.CreatedAt); err != nil {
+        if err := scanAddress(rows, &a); err != nil {
             return nil, status.Errorf(codes.Internal, "scan error: %v", err)
         }
-        addresses = append(addresses, &pb.Address{
-            Id:        uint32(a.ID),
-            Name:      a.Name,
-            Desc:      a.Desc,
-            OwnerId:   uint32(a.OwnerID),
-            CreatedAt: a.CreatedAt.Format(time.RFC3339),
-        })
+        addresses = append(addresses, toProtoAddress(&a))
     }
 
     return &pb.GetAllAddressesResponse{Addresses: addresses}, nil
@@ -0,0 +1,81 @@
+package grpc_server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"address-service/geocode"
+	"address-service/model"
+	pb "address-service/proto/address"
+	"address-service/validation"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CheckServiceability memberi tahu apakah kami mengirim ke alamat tersebut.
+func (s *AddressServer) CheckServiceability(ctx context.Context, req *pb.CheckServiceabilityRequest) (*pb.CheckServiceabilityResponse, error) {
+	var a model.Address
+	err := scanAddress(s.DB.QueryRowContext(ctx, `SELECT `+addressColumns+` FROM addresses WHERE id=$1 AND deleted_at IS NULL`, req.AddressId), &a)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "address not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	if a.OwnerID != uint(req.OwnerId) {
+		return nil, status.Errorf(codes.PermissionDenied, "unauthorized")
+	}
+
+	// alamat lama tanpa field terstruktur tidak bisa dicek
+	if a.StreetLine1 == "" || a.CountryCode == "" {
+		return &pb.CheckServiceabilityResponse{Reason: "address is incomplete"}, nil
+	}
+
+	res, err := s.Coverage.Check(ctx, geocodeQuery(&a), a.Latitude, a.Longitude)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "geocoder unavailable: %v", err)
+	}
+
+	return &pb.CheckServiceabilityResponse{
+		Serviceable: res.Serviceable,
+		Reason:      res.Reason,
+		Latitude:    res.Latitude,
+		Longitude:   res.Longitude,
+	}, nil
+}
+
+// fillCoordinates mengisi latitude/longitude dari geocoder kalau client tidak mengirimnya.
+// Kegagalan geocoding tidak menggagalkan penyimpanan alamat.
+func (s *AddressServer) fillCoordinates(ctx context.Context, f *validation.Fields) {
+	if s.Geocoder == nil || f.Latitude != nil {
+		return
+	}
+
+	loc, err := s.Geocoder.Geocode(ctx, geocode.Query{
+		StreetLine1: f.StreetLine1,
+		City:        f.City,
+		Province:    f.Province,
+		PostalCode:  f.PostalCode,
+		CountryCode: f.CountryCode,
+	})
+	if err != nil {
+		if !errors.Is(err, geocode.ErrNotFound) {
+			log.Printf("geocoding failed: %v", err)
+		}
+		return
+	}
+	f.Latitude, f.Longitude = &loc.Latitude, &loc.Longitude
+}
+
+func geocodeQuery(a *model.Address) geocode.Query {
+	return geocode.Query{
+		StreetLine1: a.StreetLine1,
+		City:        a.City,
+		Province:    a.Province,
+		PostalCode:  a.PostalCode,
+		CountryCode: a.CountryCode,
+	}
+}
@@ -0,0 +1,79 @@
+package kafka
+
+import (
+	"address-service/cache"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+const serviceName = "address-service"
+
+// === payload dari user-service ===
+type UserDeletedEvent struct {
+	EventType string `json:"event_type"`
+	Data      struct {
+		UserID    uint32 `json:"user_id"`
+		RequestID string `json:"request_id"`
+	} `json:"data"`
+}
+
+// publishErasureReport mengirim laporan penghapusan data (bukti per service) ke user-service.
+func publishErasureReport(producer *Producer, event UserDeletedEvent, details map[string]int64, err error) {
+	data := map[string]interface{}{
+		"request_id":   event.Data.RequestID,
+		"user_id":      event.Data.UserID,
+		"service":      serviceName,
+		"status":       "completed",
+		"details":      details,
+		"completed_at": time.Now().UTC().Format(time.RFC3339),
+	}
+	if err != nil {
+		data["status"] = "failed"
+		data["error"] = err.Error()
+	}
+
+	producer.PublishErasureCompletedEvent(map[string]interface{}{
+		"event_type": "user_erasure_completed",
+		"data":       data,
+	})
+}
+
+// UserDeletedHandler menghapus semua alamat milik user (event user.deleted).
+// Index alamat di search-service dibersihkan oleh search-service sendiri.
+func UserDeletedHandler(db *sql.DB, producer *Producer) func([]byte) error {
+	return func(msg []byte) error {
+		log.Printf("📥 user.deleted received: %s", string(msg))
+
+		var event UserDeletedEvent
+		if err := json.Unmarshal(msg, &event); err != nil {
+			log.Printf("❌ invalid user.deleted payload: %v", err)
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// erasure menghapus permanen, termasuk alamat yang sudah di-soft delete dan history-nya
+		details := map[string]int64{}
+		res, err := db.ExecContext(ctx, `DELETE FROM address_versions WHERE owner_id=$1`, event.Data.UserID)
+		if err == nil {
+			details["address_versions_deleted"], _ = res.RowsAffected()
+			res, err = db.ExecContext(ctx, `DELETE FROM addresses WHERE owner_id=$1`, event.Data.UserID)
+		}
+		if err == nil {
+			details["addresses_deleted"], _ = res.RowsAffected()
+			cache.Redis.Del(ctx, fmt.Sprintf("addresses:%d", event.Data.UserID))
+			log.Printf("✅ addresses of user %d deleted", event.Data.UserID)
+		} else {
+			log.Printf("❌ failed delete addresses of user %d: %v", event.Data.UserID, err)
+		}
+
+		publishErasureReport(producer, event, details, err)
+		// error dikembalikan supaya consumer mencoba ulang; laporan "failed" ditimpa saat berhasil
+		return err
+	}
+}
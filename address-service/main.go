@@ -2,6 +2,8 @@ package main
 
 import (
 	"address-service/cache"
+	"address-service/coverage"
+	"address-service/geocode"
 	"address-service/grpc_server"
 	kafkax "address-service/kafka"
 	"address-service/middleware"
@@ -9,12 +11,17 @@ import (
 	pb "address-service/proto/address"
 
 	"address-service/routes"
+	"address-service/svcauth"
+	"context"
 	"database/sql"
 	"log"
 	"net"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
@@ -41,20 +48,35 @@ func initDB() {
 	}
 
 	// AutoMigrate untuk jaga-jaga tabel ada
-	if err := DB.AutoMigrate(&model.Address{}); err != nil {
+	if err := DB.AutoMigrate(&model.Address{}, &model.AddressVersion{}); err != nil {
 		log.Fatal(err)
 	}
 
+	// satu alamat default (shipping / billing) per owner; GORM belum mendukung partial index lewat tag
+	for _, q := range []string{
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_addresses_default_shipping ON addresses (owner_id) WHERE is_default_shipping`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_addresses_default_billing ON addresses (owner_id) WHERE is_default_billing`,
+	} {
+		if err := DB.Exec(q).Error; err != nil {
+			log.Fatal("failed to create default address index:", err)
+		}
+	}
+
 	// 🟢 Ambil *sql.DB dari koneksi GORM
 	SQLDB, err = DB.DB()
 	if err != nil {
 		log.Fatal("failed to get sql.DB from gorm:", err)
 	}
+
+	if err := grpc_server.BackfillAddressHistory(context.Background(), SQLDB); err != nil {
+		log.Fatal("failed to backfill address history:", err)
+	}
 }
 
 
 
 func main() {
+	svcauth.Init()
 	initDB()
 	producer := kafkax.NewProducer()
 	cache.ConnectRedis()
@@ -66,6 +88,13 @@ func main() {
 
 		routes.RegisterAddressRoutes(app, DB, middleware.AuthMiddleware())
 
+		// stub provider untuk GEOCODER=http di development:
+		// GEOCODER_URL=http://localhost:3003/geocoder-stub
+		if getEnv("GEOCODER_STUB", "false") == "true" {
+			stub := http.StripPrefix("/geocoder-stub", geocode.StubHandler(geocode.DefaultPostalTable()))
+			app.Get("/geocoder-stub/*", adaptor.HTTPHandler(stub))
+		}
+
 		log.Println("HTTP server running on port 3003")
 		if err := app.Listen(":3003"); err != nil {
 			log.Fatal("fiber error:", err)
@@ -83,11 +112,14 @@ func main() {
         Addr: redisAddr,
  	   })
 
-		grpcServer := grpc.NewServer()
+		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(svcauth.UnaryInterceptor(svcauth.ServerPolicy)))
+		geocoder := newGeocoder()
 		addressServer := &grpc_server.AddressServer{
 			DB:            SQLDB,
 			Producer: producer,
 			Redis: rdb,
+			Geocoder: geocoder,
+			Coverage: coverage.NewChecker(geocoder, getEnv("SERVICEABLE_COUNTRIES", "ID"), getEnv("BLOCKED_POSTAL_PREFIXES", "")),
 		}
 		pb.RegisterAddressServiceServer(grpcServer, addressServer)
 
@@ -97,9 +129,44 @@ func main() {
 		}
 	}()
 
+	consumer := kafkax.NewConsumer()
+	consumer.Consume("user.deleted", kafkax.UserDeletedHandler(SQLDB, producer))
+	consumer.Start(context.Background())
+
 	select {}
 }
 
+// newGeocoder memilih implementasi geocoder dari env GEOCODER (table | http).
+func newGeocoder() geocode.Geocoder {
+	switch getEnv("GEOCODER", "table") {
+	case "http":
+		timeout, err := time.ParseDuration(getEnv("GEOCODER_TIMEOUT", "3s"))
+		if err != nil {
+			log.Fatal("invalid GEOCODER_TIMEOUT:", err)
+		}
+		return geocode.NewHTTPGeocoder(getEnv("GEOCODER_URL", "http://geocoder-stub:8080"), os.Getenv("GEOCODER_API_KEY"), timeout)
+	case "table":
+		// tabel kode pos sendiri bisa dipasang tanpa build ulang
+		path := os.Getenv("GEOCODER_POSTAL_TABLE")
+		if path == "" {
+			return geocode.DefaultPostalTable()
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatal("failed to open postal table:", err)
+		}
+		defer f.Close()
+		table, err := geocode.LoadPostalTable(f)
+		if err != nil {
+			log.Fatal("invalid postal table:", err)
+		}
+		return table
+	default:
+		log.Fatalf("unknown GEOCODER %q", getEnv("GEOCODER", ""))
+		return nil
+	}
+}
+
 func getEnv(k, d string) string {
 	if v := os.Getenv(k); v != "" {
 		return v
@@ -12,4 +12,26 @@ type Address struct {
 	Desc      string    `json:"desc"`
 	OwnerID   uint      `json:"owner_id"`
 	CreatedAt time.Time `json:"created_at"`
+
+	// alamat terstruktur untuk kurir; Name jadi label (mis. "Rumah") dan Desc catatan pengiriman.
+	// Default '' supaya alamat lama (sebelum kolom ini ada) tetap bisa di-scan.
+	Recipient   string   `gorm:"not null;default:''" json:"recipient"`
+	Phone       string   `gorm:"not null;default:''" json:"phone"`
+	StreetLine1 string   `gorm:"not null;default:''" json:"street_line1"`
+	StreetLine2 string   `gorm:"not null;default:''" json:"street_line2"`
+	City        string   `gorm:"not null;default:''" json:"city"`
+	Province    string   `gorm:"not null;default:''" json:"province"`
+	PostalCode  string   `gorm:"not null;default:''" json:"postal_code"`
+	CountryCode string   `gorm:"size:2;not null;default:''" json:"country_code"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+
+	// maksimal satu alamat default per owner untuk tiap jenis, dijaga unique index parsial
+	// yang dibuat di initDB
+	IsDefaultShipping bool `gorm:"not null;default:false" json:"is_default_shipping"`
+	IsDefaultBilling  bool `gorm:"not null;default:false" json:"is_default_billing"`
+
+	// soft delete: transaksi lama masih merujuk alamat ini, isinya disimpan di AddressVersion
+	Version   int        `gorm:"not null;default:1" json:"version"`
+	DeletedAt *time.Time `gorm:"index" json:"deleted_at,omitempty"`
 }
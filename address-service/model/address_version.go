@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// AddressVersion adalah salinan isi alamat setiap kali dibuat / diubah, supaya support
+// bisa melihat alamat persis seperti saat order dibuat.
+type AddressVersion struct {
+	ID        uint `gorm:"primaryKey" json:"id"`
+	AddressID uint `gorm:"not null;uniqueIndex:idx_address_versions_address_version" json:"address_id"`
+	Version   int  `gorm:"not null;uniqueIndex:idx_address_versions_address_version" json:"version"`
+	OwnerID   uint `gorm:"not null;index" json:"owner_id"`
+
+	Name        string   `json:"name"`
+	Desc        string   `json:"desc"`
+	Recipient   string   `gorm:"not null;default:''" json:"recipient"`
+	Phone       string   `gorm:"not null;default:''" json:"phone"`
+	StreetLine1 string   `gorm:"not null;default:''" json:"street_line1"`
+	StreetLine2 string   `gorm:"not null;default:''" json:"street_line2"`
+	City        string   `gorm:"not null;default:''" json:"city"`
+	Province    string   `gorm:"not null;default:''" json:"province"`
+	PostalCode  string   `gorm:"not null;default:''" json:"postal_code"`
+	CountryCode string   `gorm:"size:2;not null;default:''" json:"country_code"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -20,12 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AddressService_CreateAddress_FullMethodName   = "/address.AddressService/CreateAddress"
-	AddressService_GetAddress_FullMethodName      = "/address.AddressService/GetAddress"
-	AddressService_ListAddresses_FullMethodName   = "/address.AddressService/ListAddresses"
-	AddressService_UpdateAddress_FullMethodName   = "/address.AddressService/UpdateAddress"
-	AddressService_DeleteAddress_FullMethodName   = "/address.AddressService/DeleteAddress"
-	AddressService_GetAllAddresses_FullMethodName = "/address.AddressService/GetAllAddresses"
+	AddressService_CreateAddress_FullMethodName       = "/address.AddressService/CreateAddress"
+	AddressService_GetAddress_FullMethodName          = "/address.AddressService/GetAddress"
+	AddressService_ListAddresses_FullMethodName       = "/address.AddressService/ListAddresses"
+	AddressService_UpdateAddress_FullMethodName       = "/address.AddressService/UpdateAddress"
+	AddressService_DeleteAddress_FullMethodName       = "/address.AddressService/DeleteAddress"
+	AddressService_GetAllAddresses_FullMethodName     = "/address.AddressService/GetAllAddresses"
+	AddressService_SetDefaultAddress_FullMethodName   = "/address.AddressService/SetDefaultAddress"
+	AddressService_GetDefaultAddress_FullMethodName   = "/address.AddressService/GetDefaultAddress"
+	AddressService_CheckServiceability_FullMethodName = "/address.AddressService/CheckServiceability"
 )
 
 // AddressServiceClient is the client API for AddressService service.
@@ -38,6 +41,9 @@ type AddressServiceClient interface {
 	UpdateAddress(ctx context.Context, in *UpdateAddressRequest, opts ...grpc.CallOption) (*AddressResponse, error)
 	DeleteAddress(ctx context.Context, in *DeleteAddressRequest, opts ...grpc.CallOption) (*DeleteAddressResponse, error)
 	GetAllAddresses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAllAddressesResponse, error)
+	SetDefaultAddress(ctx context.Context, in *SetDefaultAddressRequest, opts ...grpc.CallOption) (*AddressResponse, error)
+	GetDefaultAddress(ctx context.Context, in *GetDefaultAddressRequest, opts ...grpc.CallOption) (*AddressResponse, error)
+	CheckServiceability(ctx context.Context, in *CheckServiceabilityRequest, opts ...grpc.CallOption) (*CheckServiceabilityResponse, error)
 }
 
 type addressServiceClient struct {
@@ -108,6 +114,36 @@ func (c *addressServiceClient) GetAllAddresses(ctx context.Context, in *emptypb.
 	return out, nil
 }
 
+func (c *addressServiceClient) SetDefaultAddress(ctx context.Context, in *SetDefaultAddressRequest, opts ...grpc.CallOption) (*AddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressResponse)
+	err := c.cc.Invoke(ctx, AddressService_SetDefaultAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressServiceClient) GetDefaultAddress(ctx context.Context, in *GetDefaultAddressRequest, opts ...grpc.CallOption) (*AddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressResponse)
+	err := c.cc.Invoke(ctx, AddressService_GetDefaultAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressServiceClient) CheckServiceability(ctx context.Context, in *CheckServiceabilityRequest, opts ...grpc.CallOption) (*CheckServiceabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckServiceabilityResponse)
+	err := c.cc.Invoke(ctx, AddressService_CheckServiceability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AddressServiceServer is the server API for AddressService service.
 // All implementations must embed UnimplementedAddressServiceServer
 // for forward compatibility.
@@ -118,6 +154,9 @@ type AddressServiceServer interface {
 	UpdateAddress(context.Context, *UpdateAddressRequest) (*AddressResponse, error)
 	DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteAddressResponse, error)
 	GetAllAddresses(context.Context, *emptypb.Empty) (*GetAllAddressesResponse, error)
+	SetDefaultAddress(context.Context, *SetDefaultAddressRequest) (*AddressResponse, error)
+	GetDefaultAddress(context.Context, *GetDefaultAddressRequest) (*AddressResponse, error)
+	CheckServiceability(context.Context, *CheckServiceabilityRequest) (*CheckServiceabilityResponse, error)
 	mustEmbedUnimplementedAddressServiceServer()
 }
 
@@ -146,6 +185,15 @@ func (UnimplementedAddressServiceServer) DeleteAddress(context.Context, *DeleteA
 func (UnimplementedAddressServiceServer) GetAllAddresses(context.Context, *emptypb.Empty) (*GetAllAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllAddresses not implemented")
 }
+func (UnimplementedAddressServiceServer) SetDefaultAddress(context.Context, *SetDefaultAddressRequest) (*AddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultAddress not implemented")
+}
+func (UnimplementedAddressServiceServer) GetDefaultAddress(context.Context, *GetDefaultAddressRequest) (*AddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultAddress not implemented")
+}
+func (UnimplementedAddressServiceServer) CheckServiceability(context.Context, *CheckServiceabilityRequest) (*CheckServiceabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckServiceability not implemented")
+}
 func (UnimplementedAddressServiceServer) mustEmbedUnimplementedAddressServiceServer() {}
 func (UnimplementedAddressServiceServer) testEmbeddedByValue()                        {}
 
@@ -275,6 +323,60 @@ func _AddressService_GetAllAddresses_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AddressService_SetDefaultAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).SetDefaultAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressService_SetDefaultAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).SetDefaultAddress(ctx, req.(*SetDefaultAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressService_GetDefaultAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDefaultAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).GetDefaultAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressService_GetDefaultAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).GetDefaultAddress(ctx, req.(*GetDefaultAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressService_CheckServiceability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckServiceabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).CheckServiceability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressService_CheckServiceability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).CheckServiceability(ctx, req.(*CheckServiceabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AddressService_ServiceDesc is the grpc.ServiceDesc for AddressService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -306,6 +408,18 @@ var AddressService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllAddresses",
 			Handler:    _AddressService_GetAllAddresses_Handler,
 		},
+		{
+			MethodName: "SetDefaultAddress",
+			Handler:    _AddressService_SetDefaultAddress_Handler,
+		},
+		{
+			MethodName: "GetDefaultAddress",
+			Handler:    _AddressService_GetDefaultAddress_Handler,
+		},
+		{
+			MethodName: "CheckServiceability",
+			Handler:    _AddressService_CheckServiceability_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/address/address.proto",
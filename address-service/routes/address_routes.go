@@ -16,9 +16,12 @@ func RegisterAddressRoutes(app *fiber.App, db *gorm.DB, authMiddleware fiber.Han
 
 	a.Get("/", authMiddleware, ac.List)
 	a.Post("/", authMiddleware, ac.Create)
-	a.Get("/all", authMiddleware,middleware.RoleRequired("admin"), ac.GetAllAddresses)
+	a.Get("/all", authMiddleware,middleware.RequirePermission("address:read_all"), ac.GetAllAddresses)
 	// a.Get("/all", authMiddleware,middleware.RoleRequired("admin"), ac.GetAllAddress)
 	a.Get("/:id", authMiddleware, ac.Get)
+	a.Get("/:id/versions/:version", authMiddleware, middleware.RequirePermission("address:read_all"), ac.GetVersion)
 	a.Put("/:id", authMiddleware, ac.Update)
+	a.Put("/:id/default", authMiddleware, ac.SetDefault)
+	a.Get("/:id/serviceability", authMiddleware, ac.Serviceability)
 	a.Delete("/:id", authMiddleware, ac.Delete)
 }
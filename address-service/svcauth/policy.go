@@ -0,0 +1,18 @@
+package svcauth
+
+// ServiceName dipakai sebagai identitas service ini di service token.
+const ServiceName = "address-service"
+
+// ServerPolicy adalah allowlist caller untuk RPC yang di-serve service ini.
+var ServerPolicy = Policy{
+	Methods: map[string][]string{
+		"/address.AddressService/GetAddress": {ServiceName, "transaction-service"},
+		// fallback CreateTransaction tanpa address_id
+		"/address.AddressService/GetDefaultAddress": {ServiceName, "transaction-service"},
+		// CreateTransaction menolak alamat di luar jangkauan pengiriman
+		"/address.AddressService/CheckServiceability": {ServiceName, "transaction-service"},
+		// export data pribadi (DSAR)
+		"/address.AddressService/ListAddresses": {ServiceName, "user-service"},
+	},
+	Default: []string{ServiceName},
+}
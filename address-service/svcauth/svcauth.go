@@ -0,0 +1,248 @@
+package svcauth
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Service token antar service: JWT EdDSA yang ditandatangani private key milik service
+// pemanggil (SERVICE_AUTH_PRIVATE_KEY_FILE), sub = nama service pemanggil, aud = gRPC service
+// tujuan (mis. "auth.AuthService"). Penerima memverifikasi dengan public key caller dari
+// SERVICE_AUTH_PUBLIC_KEYS_DIR/<nama-service>.pub, jadi satu service tidak bisa mengaku
+// sebagai service lain dan token yang diterimanya tidak bisa dipakai ke service lain.
+const (
+	metadataKey = "x-service-token"
+	tokenTTL    = 5 * time.Minute
+)
+
+// Policy menentukan service mana yang boleh memanggil tiap RPC.
+type Policy struct {
+	// Methods: full method (mis. "/transaction.TransactionService/MarkAsPaid") -> caller yang diizinkan
+	Methods map[string][]string
+	// Default berlaku untuk RPC yang tidak ada di Methods
+	Default []string
+}
+
+func (p Policy) allowed(method, caller string) bool {
+	callers, ok := p.Methods[method]
+	if !ok {
+		callers = p.Default
+	}
+	for _, c := range callers {
+		if c == caller {
+			return true
+		}
+	}
+	return false
+}
+
+// callers mengembalikan semua service yang disebut di policy.
+func (p Policy) callers() []string {
+	seen := map[string]bool{}
+	var out []string
+	add := func(list []string) {
+		for _, c := range list {
+			if !seen[c] {
+				seen[c] = true
+				out = append(out, c)
+			}
+		}
+	}
+	add(p.Default)
+	for _, list := range p.Methods {
+		add(list)
+	}
+	return out
+}
+
+// Init memuat private key service ini. Dipanggil di awal main supaya key yang tidak ada
+// menggagalkan startup, bukan baru ketahuan saat request gRPC pertama.
+func Init() {
+	privateKey()
+}
+
+var (
+	keyOnce sync.Once
+	key     crypto.PrivateKey
+)
+
+func privateKey() crypto.PrivateKey {
+	keyOnce.Do(func() {
+		path := os.Getenv("SERVICE_AUTH_PRIVATE_KEY_FILE")
+		if path == "" {
+			log.Fatal("SERVICE_AUTH_PRIVATE_KEY_FILE is not set")
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatalf("failed to read service private key: %v", err)
+		}
+		key, err = jwt.ParseEdPrivateKeyFromPEM(data)
+		if err != nil {
+			log.Fatalf("invalid service private key %s: %v", path, err)
+		}
+	})
+	return key
+}
+
+// loadPublicKeys membaca <service>.pub untuk setiap caller di policy; caller tanpa key
+// menggagalkan startup.
+func loadPublicKeys(p Policy) map[string]crypto.PublicKey {
+	dir := os.Getenv("SERVICE_AUTH_PUBLIC_KEYS_DIR")
+	if dir == "" {
+		log.Fatal("SERVICE_AUTH_PUBLIC_KEYS_DIR is not set")
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, caller := range p.callers() {
+		path := filepath.Join(dir, caller+".pub")
+		data, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatalf("failed to read public key of %s: %v", caller, err)
+		}
+		pub, err := jwt.ParseEdPublicKeyFromPEM(data)
+		if err != nil {
+			log.Fatalf("invalid public key %s: %v", path, err)
+		}
+		keys[caller] = pub
+	}
+	return keys
+}
+
+// grpcService mengambil nama gRPC service dari full method ("/auth.AuthService/Login")
+// atau dari audience URI client ("https://auth-service:50052/auth.AuthService").
+func grpcService(s string) string {
+	if u, err := url.Parse(s); err == nil && u.Scheme != "" {
+		s = u.Path
+	}
+	s = strings.TrimPrefix(s, "/")
+	if i := strings.Index(s, "/"); i >= 0 {
+		s = s[:i]
+	}
+	return s
+}
+
+// ====================== CLIENT ======================
+
+type cachedToken struct {
+	token string
+	exp   time.Time
+}
+
+type tokenSource struct {
+	key crypto.PrivateKey
+
+	mu     sync.Mutex
+	tokens map[string]cachedToken // per gRPC service tujuan
+}
+
+var (
+	sourceOnce sync.Once
+	source     *tokenSource
+)
+
+// DialOption menempelkan service token ke setiap request gRPC keluar.
+func DialOption() grpc.DialOption {
+	sourceOnce.Do(func() {
+		source = &tokenSource{key: privateKey(), tokens: map[string]cachedToken{}}
+	})
+	return grpc.WithPerRPCCredentials(source)
+}
+
+func (t *tokenSource) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	if len(uri) == 0 {
+		return nil, fmt.Errorf("svcauth: missing target service")
+	}
+	aud := grpcService(uri[0])
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// token dipakai ulang sampai hampir expired
+	cached := t.tokens[aud]
+	if cached.token == "" || time.Until(cached.exp) < time.Minute {
+		now := time.Now()
+		exp := now.Add(tokenTTL)
+		token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+			"iss": ServiceName,
+			"sub": ServiceName,
+			"aud": aud,
+			"iat": now.Unix(),
+			"exp": exp.Unix(),
+		}).SignedString(t.key)
+		if err != nil {
+			return nil, err
+		}
+		cached = cachedToken{token: token, exp: exp}
+		t.tokens[aud] = cached
+	}
+
+	return map[string]string{metadataKey: cached.token}, nil
+}
+
+func (t *tokenSource) RequireTransportSecurity() bool {
+	return false
+}
+
+// ====================== SERVER ======================
+
+type callerKey struct{}
+
+// Caller mengembalikan nama service yang memanggil RPC ini.
+func Caller(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+// UnaryInterceptor menolak request tanpa service token yang valid atau dari service
+// yang tidak ada di allowlist RPC tersebut. Public key semua caller di policy dimuat di sini.
+func UnaryInterceptor(p Policy) grpc.UnaryServerInterceptor {
+	keys := loadPublicKeys(p)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(metadataKey)
+		if len(values) == 0 {
+			return nil, status.Errorf(codes.Unauthenticated, "missing service token")
+		}
+
+		// token diverifikasi dengan public key milik service yang disebut di sub
+		token, err := jwt.Parse(values[0], func(t *jwt.Token) (interface{}, error) {
+			sub, _ := t.Claims.GetSubject()
+			pub, ok := keys[sub]
+			if !ok {
+				return nil, fmt.Errorf("unknown caller %q", sub)
+			}
+			return pub, nil
+		},
+			jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}),
+			jwt.WithAudience(grpcService(info.FullMethod)),
+			jwt.WithExpirationRequired(),
+			jwt.WithIssuedAt(),
+		)
+		if err != nil || !token.Valid {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid service token")
+		}
+
+		caller, _ := token.Claims.GetSubject()
+		if !p.allowed(info.FullMethod, caller) {
+			log.Printf("rejected %s call from %q", info.FullMethod, caller)
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", caller, info.FullMethod)
+		}
+
+		return handler(context.WithValue(ctx, callerKey{}, caller), req)
+	}
+}
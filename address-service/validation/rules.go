@@ -0,0 +1,152 @@
+package validation
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Basic adalah aturan berbasis konfigurasi yang cukup untuk sebagian besar negara.
+type Basic struct {
+	// PostalCode kosong berarti kode pos tidak divalidasi formatnya
+	PostalCode         *regexp.Regexp
+	PostalCodeExample  string
+	PostalCodeRequired bool
+	ProvinceRequired   bool
+	// Provinces (opsional) memetakan nama dalam huruf kecil ke nama bakunya
+	Provinces map[string]string
+	// CallingCode mis. "62"; nomor lokal berawalan 0 diubah ke format internasional
+	CallingCode string
+	// jumlah digit nomor tanpa kode negara
+	MinPhoneDigits int
+	MaxPhoneDigits int
+}
+
+var nonDigit = regexp.MustCompile(`\D`)
+
+// Normalize mengubah telepon ke format E.164 dan nama provinsi ke nama bakunya.
+func (b *Basic) Normalize(f *Fields) {
+	if f.Phone != "" {
+		plus := strings.HasPrefix(f.Phone, "+")
+		digits := nonDigit.ReplaceAllString(f.Phone, "")
+		switch {
+		case b.CallingCode != "" && !plus && strings.HasPrefix(digits, "0"):
+			digits = b.CallingCode + strings.TrimPrefix(digits, "0")
+		case b.CallingCode != "" && !plus && !strings.HasPrefix(digits, b.CallingCode):
+			digits = b.CallingCode + digits
+		}
+		f.Phone = "+" + digits
+	}
+
+	if name, ok := b.Provinces[strings.ToLower(f.Province)]; ok {
+		f.Province = name
+	}
+}
+
+// Validate memeriksa kode pos, provinsi dan nomor telepon.
+func (b *Basic) Validate(f *Fields) Errors {
+	var errs Errors
+
+	if f.PostalCode == "" {
+		if b.PostalCodeRequired {
+			errs.add("postal_code", "is required")
+		}
+	} else if b.PostalCode != nil && !b.PostalCode.MatchString(f.PostalCode) {
+		errs.add("postal_code", "invalid format, expected e.g. %s", b.PostalCodeExample)
+	}
+
+	if f.Province == "" {
+		if b.ProvinceRequired {
+			errs.add("province", "is required")
+		}
+	} else if b.Provinces != nil {
+		if _, ok := b.Provinces[strings.ToLower(f.Province)]; !ok {
+			errs.add("province", "unknown province %q", f.Province)
+		}
+	}
+
+	if f.Phone != "" {
+		digits := strings.TrimPrefix(f.Phone, "+")
+		if b.CallingCode != "" {
+			if !strings.HasPrefix(digits, b.CallingCode) {
+				errs.add("phone", "must be a +%s number", b.CallingCode)
+				return errs
+			}
+			digits = strings.TrimPrefix(digits, b.CallingCode)
+		}
+		if len(digits) < b.MinPhoneDigits || len(digits) > b.MaxPhoneDigits {
+			errs.add("phone", "must have %d-%d digits after the country code", b.MinPhoneDigits, b.MaxPhoneDigits)
+		}
+	}
+
+	return errs
+}
+
+func init() {
+	// negara tanpa aturan khusus: nomor telepon internasional wajib diawali +kode negara
+	genericRules = &Basic{
+		PostalCode:        regexp.MustCompile(`^[A-Z0-9][A-Z0-9 -]{1,8}[A-Z0-9]$`),
+		PostalCodeExample: "12345",
+		MinPhoneDigits:    8,
+		MaxPhoneDigits:    15,
+	}
+
+	Register("ID", &Basic{
+		PostalCode:         regexp.MustCompile(`^\d{5}$`),
+		PostalCodeExample:  "12345",
+		PostalCodeRequired: true,
+		ProvinceRequired:   true,
+		Provinces:          provinceIndex(indonesianProvinces),
+		CallingCode:        "62",
+		MinPhoneDigits:     8,
+		MaxPhoneDigits:     12,
+	})
+
+	Register("SG", &Basic{
+		PostalCode:         regexp.MustCompile(`^\d{6}$`),
+		PostalCodeExample:  "123456",
+		PostalCodeRequired: true,
+		CallingCode:        "65",
+		MinPhoneDigits:     8,
+		MaxPhoneDigits:     8,
+	})
+
+	Register("MY", &Basic{
+		PostalCode:         regexp.MustCompile(`^\d{5}$`),
+		PostalCodeExample:  "50000",
+		PostalCodeRequired: true,
+		ProvinceRequired:   true,
+		CallingCode:        "60",
+		MinPhoneDigits:     9,
+		MaxPhoneDigits:     10,
+	})
+
+	Register("US", &Basic{
+		PostalCode:         regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+		PostalCodeExample:  "94105 or 94105-1234",
+		PostalCodeRequired: true,
+		ProvinceRequired:   true,
+		CallingCode:        "1",
+		MinPhoneDigits:     10,
+		MaxPhoneDigits:     10,
+	})
+}
+
+func provinceIndex(names []string) map[string]string {
+	idx := make(map[string]string, len(names))
+	for _, n := range names {
+		idx[strings.ToLower(n)] = n
+	}
+	return idx
+}
+
+// 38 provinsi Indonesia (nama baku dipakai kurir)
+var indonesianProvinces = []string{
+	"Aceh", "Sumatera Utara", "Sumatera Barat", "Riau", "Kepulauan Riau", "Jambi",
+	"Sumatera Selatan", "Kepulauan Bangka Belitung", "Bengkulu", "Lampung",
+	"DKI Jakarta", "Jawa Barat", "Banten", "Jawa Tengah", "DI Yogyakarta", "Jawa Timur",
+	"Bali", "Nusa Tenggara Barat", "Nusa Tenggara Timur",
+	"Kalimantan Barat", "Kalimantan Tengah", "Kalimantan Selatan", "Kalimantan Timur", "Kalimantan Utara",
+	"Sulawesi Utara", "Gorontalo", "Sulawesi Tengah", "Sulawesi Barat", "Sulawesi Selatan", "Sulawesi Tenggara",
+	"Maluku", "Maluku Utara",
+	"Papua", "Papua Barat", "Papua Barat Daya", "Papua Tengah", "Papua Pegunungan", "Papua Selatan",
+}
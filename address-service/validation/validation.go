@@ -0,0 +1,133 @@
+// Package validation memvalidasi alamat terstruktur sebelum disimpan.
+// Aturan tiap negara didaftarkan lewat Register; negara yang belum punya
+// aturan sendiri memakai aturan generik.
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultCountry dipakai kalau client tidak mengirim country_code.
+const DefaultCountry = "ID"
+
+// Fields adalah bagian alamat yang divalidasi dan dinormalisasi.
+type Fields struct {
+	Recipient   string
+	Phone       string
+	StreetLine1 string
+	StreetLine2 string
+	City        string
+	Province    string
+	PostalCode  string
+	CountryCode string
+	Latitude    *float64
+	Longitude   *float64
+}
+
+// FieldError menjelaskan satu field yang tidak valid (nama field mengikuti JSON/proto).
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Errors dikembalikan Validate kalau ada field yang tidak valid.
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, fe := range e {
+		parts = append(parts, fe.Field+": "+fe.Message)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (e *Errors) add(field, format string, args ...interface{}) {
+	*e = append(*e, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Rules adalah aturan validasi satu negara. Normalize dipanggil sebelum Validate
+// dan boleh merapikan nilai (mis. format nomor telepon, nama provinsi).
+type Rules interface {
+	Normalize(f *Fields)
+	Validate(f *Fields) Errors
+}
+
+var (
+	registry     = map[string]Rules{}
+	genericRules Rules
+)
+
+// Register mendaftarkan aturan untuk country code ISO 3166-1 alpha-2.
+func Register(country string, rules Rules) {
+	registry[strings.ToUpper(country)] = rules
+}
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Validate menormalisasi f lalu menjalankan aturan umum dan aturan negaranya.
+func Validate(f *Fields) error {
+	f.Recipient = strings.TrimSpace(f.Recipient)
+	f.Phone = strings.TrimSpace(f.Phone)
+	f.StreetLine1 = strings.TrimSpace(f.StreetLine1)
+	f.StreetLine2 = strings.TrimSpace(f.StreetLine2)
+	f.City = strings.TrimSpace(f.City)
+	f.Province = strings.TrimSpace(f.Province)
+	f.PostalCode = strings.ToUpper(strings.TrimSpace(f.PostalCode))
+	f.CountryCode = strings.ToUpper(strings.TrimSpace(f.CountryCode))
+	if f.CountryCode == "" {
+		f.CountryCode = DefaultCountry
+	}
+
+	var errs Errors
+	if !countryCodePattern.MatchString(f.CountryCode) {
+		errs.add("country_code", "must be an ISO 3166-1 alpha-2 code")
+		return errs
+	}
+
+	rules, ok := registry[f.CountryCode]
+	if !ok {
+		rules = genericRules
+	}
+	rules.Normalize(f)
+
+	required(&errs, "recipient", f.Recipient, 100)
+	required(&errs, "phone", f.Phone, 20)
+	required(&errs, "street_line1", f.StreetLine1, 200)
+	maxLength(&errs, "street_line2", f.StreetLine2, 200)
+	required(&errs, "city", f.City, 100)
+	maxLength(&errs, "province", f.Province, 100)
+
+	switch {
+	case (f.Latitude == nil) != (f.Longitude == nil):
+		errs.add("latitude", "latitude and longitude must be set together")
+	case f.Latitude != nil:
+		if *f.Latitude < -90 || *f.Latitude > 90 {
+			errs.add("latitude", "must be between -90 and 90")
+		}
+		if *f.Longitude < -180 || *f.Longitude > 180 {
+			errs.add("longitude", "must be between -180 and 180")
+		}
+	}
+
+	errs = append(errs, rules.Validate(f)...)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func required(errs *Errors, field, value string, max int) {
+	if value == "" {
+		errs.add(field, "is required")
+		return
+	}
+	maxLength(errs, field, value, max)
+}
+
+func maxLength(errs *Errors, field, value string, max int) {
+	if len([]rune(value)) > max {
+		errs.add(field, "must be at most %d characters", max)
+	}
+}
@@ -2,12 +2,15 @@ package controller
 
 import (
 	"auth-service/proto/auth"
+	"auth-service/svcauth"
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"google.golang.org/grpc"
-
-	kafka "auth-service/kafka"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 
@@ -17,7 +20,7 @@ type AuthController struct {
 }
 
 func NewAuthController() *AuthController {
-	conn, err := grpc.Dial("localhost:50052", grpc.WithInsecure())
+	conn, err := grpc.Dial("localhost:50052", grpc.WithInsecure(), svcauth.DialOption())
 	if err != nil {
 		panic("failed to connect to auth gRPC: " + err.Error())
 	}
@@ -35,7 +38,6 @@ func (ac *AuthController) Register(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
-	go kafka.PublishUserCreatedEvent(res)
 
 	return c.JSON(res)
 }
@@ -46,9 +48,425 @@ func (ac *AuthController) Login(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
 	}
 
+	// X-Forwarded-For hanya dipercaya kalau request datang dari TRUSTED_PROXIES (nginx)
+	req.ClientIp = c.IP()
+
 	res, err := ac.Client.Login(context.Background(), &req)
 	if err != nil {
-		return c.Status(401).JSON(fiber.Map{"error": err.Error()})
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+func (ac *AuthController) Refresh(c *fiber.Ctx) error {
+	var req auth.RefreshTokenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	res, err := ac.Client.RefreshToken(context.Background(), &req)
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+func (ac *AuthController) Logout(c *fiber.Ctx) error {
+	var body struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	_ = c.BodyParser(&body) // body opsional
+
+	token := strings.TrimPrefix(c.Get("Authorization"), "Bearer ")
+
+	res, err := ac.Client.Logout(context.Background(), &auth.LogoutRequest{
+		AccessToken:  token,
+		RefreshToken: body.RefreshToken,
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// admin: cabut semua sesi dan API key milik user
+func (ac *AuthController) RevokeAllSessions(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	res, err := ac.Client.RevokeAllSessions(context.Background(), &auth.RevokeAllSessionsRequest{
+		UserId: uint32(id),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+func (ac *AuthController) RequestPasswordReset(c *fiber.Ctx) error {
+	var req auth.RequestPasswordResetRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	res, err := ac.Client.RequestPasswordReset(context.Background(), &req)
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+func (ac *AuthController) ConfirmPasswordReset(c *fiber.Ctx) error {
+	var req auth.ConfirmPasswordResetRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	res, err := ac.Client.ConfirmPasswordReset(context.Background(), &req)
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+func (ac *AuthController) VerifyEmail(c *fiber.Ctx) error {
+	var req auth.VerifyEmailRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	res, err := ac.Client.VerifyEmail(context.Background(), &req)
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+func (ac *AuthController) ResendVerification(c *fiber.Ctx) error {
+	var req auth.ResendVerificationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	res, err := ac.Client.ResendVerification(context.Background(), &req)
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// admin: berikan role ke user
+func (ac *AuthController) AssignRole(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var body struct {
+		Role string `json:"role"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	res, err := ac.Client.AssignRole(context.Background(), &auth.RoleChangeRequest{
+		UserId:  uint32(id),
+		Role:    body.Role,
+		ActorId: c.Locals("user_id").(uint32),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// admin: cabut role dari user
+func (ac *AuthController) RevokeRole(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	res, err := ac.Client.RevokeRole(context.Background(), &auth.RoleChangeRequest{
+		UserId:  uint32(id),
+		Role:    c.Params("role"),
+		ActorId: c.Locals("user_id").(uint32),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// admin: daftar role beserta permission-nya
+func (ac *AuthController) ListRoles(c *fiber.Ctx) error {
+	res, err := ac.Client.ListRoles(context.Background(), &auth.ListRolesRequest{})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// admin: buat / ubah role
+func (ac *AuthController) UpsertRole(c *fiber.Ctx) error {
+	var req auth.UpsertRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+	req.Name = c.Params("name")
+
+	res, err := ac.Client.UpsertRole(context.Background(), &req)
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// 2FA: bearer token boleh access token atau challenge token dari login
+func (ac *AuthController) EnrollTOTP(c *fiber.Ctx) error {
+	token := strings.TrimPrefix(c.Get("Authorization"), "Bearer ")
+	if token == "" {
+		return c.Status(401).JSON(fiber.Map{"error": "missing token"})
+	}
+
+	res, err := ac.Client.EnrollTOTP(context.Background(), &auth.EnrollTOTPRequest{Token: token})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+func (ac *AuthController) ConfirmTOTP(c *fiber.Ctx) error {
+	token := strings.TrimPrefix(c.Get("Authorization"), "Bearer ")
+	if token == "" {
+		return c.Status(401).JSON(fiber.Map{"error": "missing token"})
+	}
+
+	var body struct {
+		Code string `json:"code"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	res, err := ac.Client.ConfirmTOTP(context.Background(), &auth.ConfirmTOTPRequest{
+		Token: token,
+		Code:  body.Code,
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+func (ac *AuthController) VerifyTOTP(c *fiber.Ctx) error {
+	var req auth.VerifyTOTPRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	res, err := ac.Client.VerifyTOTP(context.Background(), &req)
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// admin: buka lockout akun
+func (ac *AuthController) UnlockAccount(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	res, err := ac.Client.UnlockAccount(context.Background(), &auth.UnlockAccountRequest{
+		UserId: uint32(id),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// admin: suspend akun user (reason wajib)
+func (ac *AuthController) SuspendUser(c *fiber.Ctx) error {
+	return ac.changeAccountStatus(c, ac.Client.SuspendUser)
+}
+
+// admin: aktifkan kembali akun yang di-suspend
+func (ac *AuthController) ReactivateUser(c *fiber.Ctx) error {
+	return ac.changeAccountStatus(c, ac.Client.ReactivateUser)
+}
+
+func (ac *AuthController) changeAccountStatus(c *fiber.Ctx, call func(context.Context, *auth.AccountStatusRequest, ...grpc.CallOption) (*auth.AccountStatusResponse, error)) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	res, err := call(context.Background(), &auth.AccountStatusRequest{
+		UserId:  uint32(id),
+		ActorId: c.Locals("user_id").(uint32),
+		Reason:  body.Reason,
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// support: token read-only untuk melihat storefront sebagai user
+func (ac *AuthController) Impersonate(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	res, err := ac.Client.Impersonate(context.Background(), &auth.ImpersonateRequest{
+		UserId:  uint32(id),
+		ActorId: c.Locals("user_id").(uint32),
+		Reason:  body.Reason,
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// OAuth: redirect ke halaman login provider (?redirect=false untuk mendapatkan URL-nya saja)
+func (ac *AuthController) OAuthStart(c *fiber.Ctx) error {
+	res, err := ac.Client.OAuthStart(context.Background(), &auth.OAuthStartRequest{
+		Provider: c.Params("provider"),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	if c.Query("redirect") == "false" {
+		return c.JSON(res)
+	}
+	return c.Redirect(res.AuthorizationUrl, fiber.StatusFound)
+}
+
+func (ac *AuthController) OAuthCallback(c *fiber.Ctx) error {
+	if errMsg := c.Query("error"); errMsg != "" {
+		return c.Status(401).JSON(fiber.Map{"error": "provider error: " + errMsg})
+	}
+
+	res, err := ac.Client.OAuthCallback(context.Background(), &auth.OAuthCallbackRequest{
+		Provider: c.Params("provider"),
+		Code:     c.Query("code"),
+		State:    c.Query("state"),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.JSON(res)
+}
+
+// admin: buat API key untuk client mesin
+func (ac *AuthController) CreateAPIKey(c *fiber.Ctx) error {
+	var req auth.CreateAPIKeyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+	req.CreatedBy = c.Locals("user_id").(uint32)
+
+	res, err := ac.Client.CreateAPIKey(context.Background(), &req)
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	return c.Status(201).JSON(res)
+}
+
+// admin: daftar API key (?user_id= untuk filter)
+func (ac *AuthController) ListAPIKeys(c *fiber.Ctx) error {
+	userID, _ := strconv.Atoi(c.Query("user_id"))
+
+	res, err := ac.Client.ListAPIKeys(context.Background(), &auth.ListAPIKeysRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
+	}
+
+	if res.Keys == nil {
+		res.Keys = []*auth.APIKeyInfo{}
+	}
+	return c.JSON(res)
+}
+
+// admin: cabut API key
+func (ac *AuthController) RevokeAPIKey(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	res, err := ac.Client.RevokeAPIKey(context.Background(), &auth.RevokeAPIKeyRequest{
+		Id: uint32(id),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
 	}
 
 	return c.JSON(res)
@@ -56,7 +474,8 @@ func (ac *AuthController) Login(c *fiber.Ctx) error {
 
 func (ac *AuthController) ValidateToken(c *fiber.Ctx) error {
 	type TokenReq struct {
-		Token string `json:"token"`
+		Token  string `json:"token"`
+		APIKey string `json:"api_key"`
 	}
 
 	var req TokenReq
@@ -64,10 +483,32 @@ func (ac *AuthController) ValidateToken(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
 	}
 
-	res, err := ac.Client.ValidateToken(context.Background(), &auth.ValidateTokenRequest{Token: req.Token})
+	res, err := ac.Client.ValidateToken(context.Background(), &auth.ValidateTokenRequest{Token: req.Token, ApiKey: req.APIKey})
 	if err != nil {
-		return c.Status(401).JSON(fiber.Map{"error": err.Error()})
+		st, _ := status.FromError(err)
+		return c.Status(grpcToHTTP(st.Code())).JSON(fiber.Map{"error": st.Message()})
 	}
 
 	return c.JSON(res)
 }
+
+func grpcToHTTP(code codes.Code) int {
+	switch code {
+	case codes.NotFound:
+		return fiber.StatusNotFound
+	case codes.PermissionDenied:
+		return fiber.StatusForbidden
+	case codes.InvalidArgument:
+		return fiber.StatusBadRequest
+	case codes.Unauthenticated:
+		return fiber.StatusUnauthorized
+	case codes.FailedPrecondition:
+		return fiber.StatusConflict
+	case codes.ResourceExhausted:
+		return fiber.StatusTooManyRequests
+	case codes.Unavailable:
+		return fiber.StatusServiceUnavailable
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"auth-service/keys"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type JWKSController struct {
+	Keys *keys.Manager
+}
+
+func NewJWKSController(km *keys.Manager) *JWKSController {
+	return &JWKSController{Keys: km}
+}
+
+// GetJWKS mempublikasikan public key untuk verifikasi JWT oleh service lain.
+func (jc *JWKSController) GetJWKS(c *fiber.Ctx) error {
+	c.Set("Cache-Control", "public, max-age=300")
+	return c.JSON(jc.Keys.JWKS())
+}
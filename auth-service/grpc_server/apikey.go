@@ -0,0 +1,186 @@
+package grpc_server
+
+import (
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	apiKeyPrefix = "gek_"
+	// last_used_at tidak ditulis di setiap request
+	apiKeyTouchInterval = time.Minute
+)
+
+// CreateAPIKey (admin) membuat API key baru. Key lengkap hanya dikembalikan sekali.
+func (s *AuthServer) CreateAPIKey(ctx context.Context, in *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if len(in.Scopes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one scope is required")
+	}
+	for _, scope := range in.Scopes {
+		if _, ok := permissionCatalog[scope]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown scope %q", scope)
+		}
+	}
+	if in.ExpiresInDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "expires_in_days must not be negative")
+	}
+
+	ownerID := in.UserId
+	if ownerID == 0 {
+		ownerID = in.CreatedBy
+	}
+	var owner model.User
+	if err := s.DB.WithContext(ctx).First(&owner, ownerID).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	secret, err := newOpaqueToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate key: %v", err)
+	}
+	raw := apiKeyPrefix + secret
+
+	key := model.APIKey{
+		Name:      name,
+		Prefix:    raw[:len(apiKeyPrefix)+8],
+		KeyHash:   hashToken(raw),
+		UserID:    owner.ID,
+		Scopes:    in.Scopes,
+		CreatedBy: uint(in.CreatedBy),
+	}
+	if in.ExpiresInDays > 0 {
+		exp := time.Now().Add(time.Duration(in.ExpiresInDays) * 24 * time.Hour)
+		key.ExpiresAt = &exp
+	}
+	if err := s.DB.WithContext(ctx).Create(&key).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save key: %v", err)
+	}
+
+	return &pb.CreateAPIKeyResponse{Key: raw, Info: toAPIKeyInfo(&key)}, nil
+}
+
+// ListAPIKeys (admin) menampilkan key tanpa nilai rahasianya.
+func (s *AuthServer) ListAPIKeys(ctx context.Context, in *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	q := s.DB.WithContext(ctx).Order("created_at DESC")
+	if in.UserId != 0 {
+		q = q.Where("user_id = ?", in.UserId)
+	}
+
+	var keys []model.APIKey
+	if err := q.Find(&keys).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	res := &pb.ListAPIKeysResponse{}
+	for i := range keys {
+		res.Keys = append(res.Keys, toAPIKeyInfo(&keys[i]))
+	}
+	return res, nil
+}
+
+// RevokeAPIKey (admin) menonaktifkan key secara permanen.
+func (s *AuthServer) RevokeAPIKey(ctx context.Context, in *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	res := s.DB.WithContext(ctx).Model(&model.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", in.Id).
+		Update("revoked_at", time.Now())
+	if res.Error != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke key: %v", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "api key not found or already revoked")
+	}
+
+	return &pb.RevokeAPIKeyResponse{Message: "api key revoked"}, nil
+}
+
+// validateAPIKey dipanggil ValidateToken untuk request yang memakai header X-API-Key.
+// Permission efektif = scope key yang masih dimiliki role pemiliknya saat ini.
+func (s *AuthServer) validateAPIKey(ctx context.Context, raw string) (*pb.ValidateTokenResponse, error) {
+	if !strings.HasPrefix(raw, apiKeyPrefix) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid api key")
+	}
+
+	db := s.DB.WithContext(ctx)
+	var key model.APIKey
+	err := db.Where("key_hash = ?", hashToken(raw)).First(&key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid api key")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	if key.RevokedAt != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "api key revoked")
+	}
+	if key.ExpiresAt != nil && time.Now().After(*key.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "api key expired")
+	}
+
+	var owner model.User
+	if err := db.First(&owner, key.UserID).Error; err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "api key owner not found")
+	}
+	if err := checkNotSuspended(&owner); err != nil {
+		return nil, err
+	}
+
+	rolePerms, err := permissionsForRole(db, owner.Role)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	allowed := make(map[string]bool, len(rolePerms))
+	for _, p := range rolePerms {
+		allowed[p] = true
+	}
+	perms := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		if allowed[scope] {
+			perms = append(perms, scope)
+		}
+	}
+
+	if key.LastUsedAt == nil || time.Since(*key.LastUsedAt) > apiKeyTouchInterval {
+		db.Model(&key).Update("last_used_at", time.Now())
+	}
+
+	return &pb.ValidateTokenResponse{
+		Id:          uint32(owner.ID),
+		Email:       owner.Email,
+		Role:        owner.Role,
+		Permissions: perms,
+		ApiKeyId:    uint32(key.ID),
+	}, nil
+}
+
+func toAPIKeyInfo(key *model.APIKey) *pb.APIKeyInfo {
+	info := &pb.APIKeyInfo{
+		Id:        uint32(key.ID),
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		UserId:    uint32(key.UserID),
+		Scopes:    key.Scopes,
+		CreatedAt: key.CreatedAt.Format(time.RFC3339),
+	}
+	if key.ExpiresAt != nil {
+		info.ExpiresAt = key.ExpiresAt.Format(time.RFC3339)
+	}
+	if key.LastUsedAt != nil {
+		info.LastUsedAt = key.LastUsedAt.Format(time.RFC3339)
+	}
+	if key.RevokedAt != nil {
+		info.RevokedAt = key.RevokedAt.Format(time.RFC3339)
+	}
+	return info
+}
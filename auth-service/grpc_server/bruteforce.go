@@ -0,0 +1,163 @@
+package grpc_server
+
+import (
+	"auth-service/kafka"
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LoginPolicy mengatur proteksi brute-force di Login.
+type LoginPolicy struct {
+	MaxFailuresPerEmail int64         // gagal sebanyak ini -> akun dikunci sementara
+	MaxFailuresPerIP    int64         // gagal sebanyak ini dari satu IP -> IP diblokir sementara
+	FailureWindow       time.Duration // counter gagal di-reset setelah window ini
+	LockoutDuration     time.Duration
+	BaseDelay           time.Duration // jeda setelah gagal, berlipat dua tiap kegagalan berikutnya
+	MaxDelay            time.Duration
+}
+
+// Key Redis:
+//
+//	auth:login:fail:email:<email> / auth:login:fail:ip:<ip>  -> jumlah gagal dalam FailureWindow
+//	auth:login:wait:email:<email>                            -> jeda progresif sebelum boleh mencoba lagi
+//	auth:login:lock:email:<email> / auth:login:lock:ip:<ip>  -> lockout sementara
+func loginKey(kind, scope, value string) string {
+	return fmt.Sprintf("auth:login:%s:%s:%s", kind, scope, value)
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// checkLoginAllowed menolak login selama email / IP dikunci atau masih dalam jeda.
+func (s *AuthServer) checkLoginAllowed(ctx context.Context, email, ip string) error {
+	keys := []string{loginKey("lock", "email", email), loginKey("wait", "email", email)}
+	if ip != "" {
+		keys = append(keys, loginKey("lock", "ip", ip))
+	}
+
+	for _, key := range keys {
+		ttl, err := s.Redis.PTTL(ctx, key).Result()
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "rate limit check failed: %v", err)
+		}
+		// -2: key tidak ada
+		if ttl < 0 {
+			continue
+		}
+		retry := int64(math.Ceil(ttl.Seconds()))
+		if strings.Contains(key, ":lock:") {
+			return status.Errorf(codes.ResourceExhausted, "too many failed attempts, try again in %ds", retry)
+		}
+		return status.Errorf(codes.ResourceExhausted, "please wait %ds before trying again", retry)
+	}
+	return nil
+}
+
+// recordLoginFailure menaikkan counter, memasang jeda progresif, dan mengunci akun / IP
+// kalau batas terlewati. user boleh nil (email tidak terdaftar).
+func (s *AuthServer) recordLoginFailure(ctx context.Context, email, ip string, user *model.User, reason string) {
+	p := s.LoginPolicy
+
+	emailFails, err := s.incrWithWindow(ctx, loginKey("fail", "email", email))
+	if err != nil {
+		return
+	}
+	var ipFails int64
+	if ip != "" {
+		ipFails, _ = s.incrWithWindow(ctx, loginKey("fail", "ip", ip))
+	}
+
+	delay := time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(emailFails-1)))
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay > 0 {
+		s.Redis.Set(ctx, loginKey("wait", "email", email), 1, delay)
+	}
+
+	event := map[string]interface{}{
+		"email":     email,
+		"client_ip": ip,
+		"reason":    reason,
+		"failures":  emailFails,
+		"at":        time.Now(),
+	}
+	if user != nil {
+		event["user_id"] = user.ID
+	}
+	go kafka.PublishLoginFailedEvent(event)
+
+	lockedUntil := time.Now().Add(p.LockoutDuration)
+	if emailFails >= p.MaxFailuresPerEmail {
+		// SetNX: event locked hanya dikirim sekali per lockout
+		if ok, _ := s.Redis.SetNX(ctx, loginKey("lock", "email", email), 1, p.LockoutDuration).Result(); ok {
+			locked := map[string]interface{}{
+				"email":        email,
+				"client_ip":    ip,
+				"scope":        "email",
+				"failures":     emailFails,
+				"locked_until": lockedUntil,
+			}
+			if user != nil {
+				locked["user_id"] = user.ID
+			}
+			go kafka.PublishAccountLockedEvent(locked)
+		}
+	}
+	if ip != "" && ipFails >= p.MaxFailuresPerIP {
+		if ok, _ := s.Redis.SetNX(ctx, loginKey("lock", "ip", ip), 1, p.LockoutDuration).Result(); ok {
+			go kafka.PublishAccountLockedEvent(map[string]interface{}{
+				"client_ip":    ip,
+				"scope":        "ip",
+				"failures":     ipFails,
+				"locked_until": lockedUntil,
+			})
+		}
+	}
+}
+
+// clearLoginFailures dipanggil setelah login sukses penuh (termasuk langkah 2FA).
+// Counter IP tidak di-reset supaya satu akun valid tidak bisa dipakai untuk menutupi
+// percobaan ke akun lain.
+func (s *AuthServer) clearLoginFailures(ctx context.Context, email string) {
+	s.Redis.Del(ctx, loginKey("fail", "email", email), loginKey("wait", "email", email))
+}
+
+func (s *AuthServer) incrWithWindow(ctx context.Context, key string) (int64, error) {
+	n, err := s.Redis.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if n == 1 {
+		s.Redis.Expire(ctx, key, s.LoginPolicy.FailureWindow)
+	}
+	return n, nil
+}
+
+// UnlockAccount (admin) membuka lockout dan me-reset counter gagal milik akun.
+func (s *AuthServer) UnlockAccount(ctx context.Context, in *pb.UnlockAccountRequest) (*pb.UnlockAccountResponse, error) {
+	var user model.User
+	if err := s.DB.WithContext(ctx).First(&user, in.UserId).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	email := normalizeEmail(user.Email)
+	if err := s.Redis.Del(ctx,
+		loginKey("lock", "email", email),
+		loginKey("fail", "email", email),
+		loginKey("wait", "email", email),
+	).Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unlock account: %v", err)
+	}
+
+	return &pb.UnlockAccountResponse{Message: "account unlocked"}, nil
+}
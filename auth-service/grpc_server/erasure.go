@@ -0,0 +1,96 @@
+package grpc_server
+
+import (
+	"auth-service/kafka"
+	"auth-service/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const serviceName = "auth-service"
+
+type userDeletedEvent struct {
+	EventType string `json:"event_type"`
+	Data      struct {
+		UserID    uint   `json:"user_id"`
+		RequestID string `json:"request_id"`
+	} `json:"data"`
+}
+
+// HandleUserDeleted menganonimkan kredensial user yang menghapus akunnya (event user.deleted)
+// dan mencabut semua sesinya. RoleAuditLog disimpan karena hanya berisi ID.
+func (s *AuthServer) HandleUserDeleted(msg []byte) error {
+	var event userDeletedEvent
+	if err := json.Unmarshal(msg, &event); err != nil {
+		log.Printf("❌ invalid user.deleted payload: %v", err)
+		return nil
+	}
+	userID := event.Data.UserID
+	if userID == 0 {
+		log.Println("user.deleted missing user_id")
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	details := map[string]int64{}
+	revoked, err := s.revokeAllSessions(ctx, userID)
+	if err == nil {
+		details["sessions_revoked"] = revoked
+		err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			res := tx.Model(&model.User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+				"email":          fmt.Sprintf("deleted-%d@deleted.invalid", userID),
+				"name":           "Deleted User",
+				"password":       "",
+				"email_verified": false,
+				"totp_secret":    "",
+				"totp_enabled":   false,
+			})
+			if res.Error != nil {
+				return res.Error
+			}
+			details["users_anonymized"] = res.RowsAffected
+
+			for name, m := range map[string]interface{}{
+				"refresh_tokens_deleted":        &model.RefreshToken{},
+				"password_reset_tokens_deleted": &model.PasswordResetToken{},
+				"verification_tokens_deleted":   &model.EmailVerificationToken{},
+				"recovery_codes_deleted":        &model.RecoveryCode{},
+				"identities_deleted":            &model.UserIdentity{},
+				"api_keys_deleted":              &model.APIKey{},
+			} {
+				res := tx.Where("user_id = ?", userID).Delete(m)
+				if res.Error != nil {
+					return res.Error
+				}
+				details[name] = res.RowsAffected
+			}
+			return nil
+		})
+	}
+
+	report := map[string]interface{}{
+		"request_id":   event.Data.RequestID,
+		"user_id":      userID,
+		"service":      serviceName,
+		"status":       "completed",
+		"details":      details,
+		"completed_at": time.Now().UTC().Format(time.RFC3339),
+	}
+	if err != nil {
+		log.Printf("❌ failed to erase user %d: %v", userID, err)
+		report["status"] = "failed"
+		report["error"] = err.Error()
+	} else {
+		log.Printf("✅ user %d erased from auth-service", userID)
+	}
+	kafka.PublishErasureCompletedEvent(report)
+	// error dikembalikan supaya consumer mencoba ulang; laporan "failed" ditimpa saat berhasil
+	return err
+}
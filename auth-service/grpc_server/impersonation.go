@@ -0,0 +1,82 @@
+package grpc_server
+
+import (
+	"auth-service/kafka"
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// Impersonate (support) menerbitkan token read-only berumur pendek atas nama customer.
+// Setiap token dicatat di audit log beserta alasan dan jti-nya.
+func (s *AuthServer) Impersonate(ctx context.Context, in *pb.ImpersonateRequest) (*pb.ImpersonateResponse, error) {
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+	if len(reason) > maxReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxReasonLength)
+	}
+	if in.ActorId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "actor_id is required")
+	}
+	if in.UserId == in.ActorId {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot impersonate yourself")
+	}
+
+	db := s.DB.WithContext(ctx)
+	var user model.User
+	err := db.First(&user, in.UserId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	// hanya akun customer, supaya impersonation tidak bisa dipakai melihat akun staf lain
+	if user.Role != roleUser {
+		return nil, status.Errorf(codes.PermissionDenied, "only customer accounts can be impersonated")
+	}
+	if err := checkNotSuspended(&user); err != nil {
+		return nil, err
+	}
+
+	token, jti, err := s.signImpersonationToken(&user, uint(in.ActorId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue token: %v", err)
+	}
+
+	audit := model.AccountAuditLog{
+		UserID:   user.ID,
+		ActorID:  uint(in.ActorId),
+		Action:   "impersonate",
+		Reason:   reason,
+		TokenJTI: jti,
+	}
+	if err := db.Create(&audit).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write audit log: %v", err)
+	}
+
+	expiresAt := time.Now().Add(s.ImpersonationTTL)
+	go kafka.PublishImpersonationStartedEvent(map[string]interface{}{
+		"user_id":    user.ID,
+		"actor_id":   in.ActorId,
+		"reason":     reason,
+		"jti":        jti,
+		"expires_at": expiresAt,
+	})
+
+	return &pb.ImpersonateResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(s.ImpersonationTTL.Seconds()),
+		User:        toAuthResponse(&user),
+	}, nil
+}
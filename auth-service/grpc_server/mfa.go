@@ -0,0 +1,288 @@
+package grpc_server
+
+import (
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"auth-service/totp"
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	recoveryCodeCount    = 10
+	maxChallengeAttempts = 5
+)
+
+// requiresMFA: role yang ada di policy wajib memakai 2FA
+func (s *AuthServer) requiresMFA(user *model.User) bool {
+	return user.TOTPEnabled || s.MFARequiredRoles[user.Role]
+}
+
+// mfaChallenge adalah respon login langkah pertama untuk user dengan 2FA.
+func (s *AuthServer) mfaChallenge(user *model.User) (*pb.LoginResponse, error) {
+	challenge, err := s.signChallengeToken(user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue challenge: %v", err)
+	}
+
+	return &pb.LoginResponse{
+		MfaRequired:           user.TOTPEnabled,
+		MfaEnrollmentRequired: !user.TOTPEnabled,
+		ChallengeToken:        challenge,
+	}, nil
+}
+
+// EnrollTOTP membuat secret baru. 2FA belum aktif sampai ConfirmTOTP berhasil.
+func (s *AuthServer) EnrollTOTP(ctx context.Context, in *pb.EnrollTOTPRequest) (*pb.EnrollTOTPResponse, error) {
+	user, _, err := s.userFromEnrollmentToken(ctx, in.Token)
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "2FA already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+	}
+	if err := s.DB.WithContext(ctx).Model(user).Updates(map[string]interface{}{
+		"totp_secret":    secret,
+		"totp_last_step": 0,
+	}).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save secret: %v", err)
+	}
+
+	return &pb.EnrollTOTPResponse{
+		Secret:     secret,
+		OtpauthUrl: totp.URI(s.TOTPIssuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP mengaktifkan 2FA setelah user membuktikan authenticator-nya bekerja,
+// lalu mengembalikan recovery codes (hanya ditampilkan sekali).
+func (s *AuthServer) ConfirmTOTP(ctx context.Context, in *pb.ConfirmTOTPRequest) (*pb.ConfirmTOTPResponse, error) {
+	user, challengeJTI, err := s.userFromEnrollmentToken(ctx, in.Token)
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "2FA already enabled")
+	}
+	if user.TOTPSecret == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "2FA enrollment not started")
+	}
+
+	step, ok := totp.Validate(user.TOTPSecret, in.Code, time.Now(), user.TOTPLastStep)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid code")
+	}
+
+	codesPlain := make([]string, 0, recoveryCodeCount)
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(user).Updates(map[string]interface{}{
+			"totp_enabled":   true,
+			"totp_last_step": step,
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", user.ID).Delete(&model.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		for i := 0; i < recoveryCodeCount; i++ {
+			code, err := newRecoveryCode()
+			if err != nil {
+				return err
+			}
+			if err := tx.Create(&model.RecoveryCode{UserID: user.ID, CodeHash: hashToken(code)}).Error; err != nil {
+				return err
+			}
+			codesPlain = append(codesPlain, code)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to enable 2FA: %v", err)
+	}
+
+	res := &pb.ConfirmTOTPResponse{RecoveryCodes: codesPlain}
+
+	// enrollment saat login: langsung lanjutkan login
+	if challengeJTI != "" {
+		if err := checkNotSuspended(user); err != nil {
+			return nil, err
+		}
+		if err := s.consumeChallenge(ctx, challengeJTI); err != nil {
+			return nil, err
+		}
+		tokens, _, err := s.issueTokens(s.DB.WithContext(ctx), user, "")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to issue tokens: %v", err)
+		}
+		res.Tokens = tokens
+		s.clearLoginFailures(ctx, normalizeEmail(user.Email))
+	}
+
+	return res, nil
+}
+
+// VerifyTOTP adalah langkah kedua login: challenge token + kode TOTP (atau recovery code).
+func (s *AuthServer) VerifyTOTP(ctx context.Context, in *pb.VerifyTOTPRequest) (*pb.LoginResponse, error) {
+	claims, err := s.parseChallengeToken(in.ChallengeToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	jti, _ := claims["jti"].(string)
+
+	if err := s.countChallengeAttempt(ctx, jti); err != nil {
+		return nil, err
+	}
+
+	var user model.User
+	if err := s.DB.WithContext(ctx).First(&user, claimInt64(claims, "sub")).Error; err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	if !user.TOTPEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "2FA not enabled")
+	}
+	if err := checkNotSuspended(&user); err != nil {
+		return nil, err
+	}
+
+	// batas per challenge saja tidak cukup karena tiap Login membuat challenge baru;
+	// kode yang salah dihitung per akun dan ikut lockout login
+	email := normalizeEmail(user.Email)
+	if err := s.checkLoginAllowed(ctx, email, ""); err != nil {
+		return nil, err
+	}
+
+	ok, err := s.checkSecondFactor(ctx, &user, in.Code)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify code: %v", err)
+	}
+	if !ok {
+		s.recordLoginFailure(ctx, email, "", &user, "invalid_second_factor")
+		return nil, status.Errorf(codes.Unauthenticated, "invalid code")
+	}
+
+	if err := s.consumeChallenge(ctx, jti); err != nil {
+		return nil, err
+	}
+
+	resp, _, err := s.issueTokens(s.DB.WithContext(ctx), &user, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue tokens: %v", err)
+	}
+	s.clearLoginFailures(ctx, email)
+	return resp, nil
+}
+
+// ====================== HELPER ======================
+
+// userFromEnrollmentToken menerima access token biasa, atau challenge token
+// dari login yang mewajibkan enrollment. jti challenge dikembalikan kalau token-nya challenge.
+func (s *AuthServer) userFromEnrollmentToken(ctx context.Context, token string) (*model.User, string, error) {
+	var (
+		userID       int64
+		challengeJTI string
+	)
+
+	if claims, err := s.parseAccessToken(token); err == nil {
+		jti, _ := claims["jti"].(string)
+		userID = claimInt64(claims, "sub")
+		revoked, err := s.isRevoked(ctx, jti, uint(userID), claimMicros(claims, "iat"))
+		if err != nil {
+			return nil, "", status.Errorf(codes.Unavailable, "revocation check failed: %v", err)
+		}
+		if revoked {
+			return nil, "", status.Errorf(codes.Unauthenticated, "token revoked")
+		}
+	} else if claims, err := s.parseChallengeToken(token); err == nil {
+		challengeJTI, _ = claims["jti"].(string)
+		userID = claimInt64(claims, "sub")
+		if err := s.countChallengeAttempt(ctx, challengeJTI); err != nil {
+			return nil, "", err
+		}
+	} else {
+		return nil, "", status.Errorf(codes.Unauthenticated, "invalid token")
+	}
+
+	var user model.User
+	if err := s.DB.WithContext(ctx).First(&user, userID).Error; err != nil {
+		return nil, "", status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	return &user, challengeJTI, nil
+}
+
+// checkSecondFactor mencoba kode sebagai TOTP dulu, lalu sebagai recovery code.
+func (s *AuthServer) checkSecondFactor(ctx context.Context, user *model.User, code string) (bool, error) {
+	if step, ok := totp.Validate(user.TOTPSecret, code, time.Now(), user.TOTPLastStep); ok {
+		// update bersyarat supaya request paralel dengan kode yang sama tidak lolos dua kali
+		res := s.DB.WithContext(ctx).Model(&model.User{}).
+			Where("id = ? AND totp_last_step < ?", user.ID, step).
+			Update("totp_last_step", step)
+		return res.RowsAffected == 1, res.Error
+	}
+
+	res := s.DB.WithContext(ctx).Model(&model.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", user.ID, hashToken(normalizeRecoveryCode(code))).
+		Update("used_at", time.Now())
+	return res.RowsAffected == 1, res.Error
+}
+
+// countChallengeAttempt membatasi jumlah percobaan kode per challenge token.
+func (s *AuthServer) countChallengeAttempt(ctx context.Context, jti string) error {
+	key := fmt.Sprintf("auth:mfa:attempts:%s", jti)
+	n, err := s.Redis.Incr(ctx, key).Result()
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "attempt check failed: %v", err)
+	}
+	if n == 1 {
+		s.Redis.Expire(ctx, key, s.MFAChallengeTTL)
+	}
+	if n > maxChallengeAttempts {
+		return status.Errorf(codes.Unauthenticated, "too many attempts, please login again")
+	}
+	return nil
+}
+
+// consumeChallenge memastikan challenge token hanya bisa menyelesaikan login sekali.
+func (s *AuthServer) consumeChallenge(ctx context.Context, jti string) error {
+	ok, err := s.Redis.SetNX(ctx, fmt.Sprintf("auth:mfa:used:%s", jti), 1, s.MFAChallengeTTL).Result()
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "challenge check failed: %v", err)
+	}
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "challenge token already used")
+	}
+	return nil
+}
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// newRecoveryCode menghasilkan kode seperti "abcde-fghij".
+func newRecoveryCode() (string, error) {
+	b := make([]byte, 7)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	s := strings.ToLower(recoveryEncoding.EncodeToString(b))[:10]
+	return s[:5] + "-" + s[5:], nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, " ", "")
+	if len(code) == 10 && !strings.Contains(code, "-") {
+		code = code[:5] + "-" + code[5:]
+	}
+	return code
+}
@@ -0,0 +1,258 @@
+package grpc_server
+
+import (
+	"auth-service/model"
+	"auth-service/oauth"
+	pb "auth-service/proto/auth"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// state login OAuth disimpan di Redis sampai callback (sekali pakai)
+type oauthState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+}
+
+func oauthStateKey(state string) string {
+	return fmt.Sprintf("auth:oauth:state:%s", state)
+}
+
+// OAuthStart membuat state + PKCE lalu mengembalikan URL login provider.
+func (s *AuthServer) OAuthStart(ctx context.Context, in *pb.OAuthStartRequest) (*pb.OAuthStartResponse, error) {
+	provider, ok := s.OAuth.Get(in.Provider)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown provider %q", in.Provider)
+	}
+
+	st := oauthState{Provider: provider.Name()}
+	state, err := oauth.RandomString()
+	if err == nil {
+		st.CodeVerifier, err = oauth.RandomString()
+	}
+	if err == nil {
+		st.Nonce, err = oauth.RandomString()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	url, err := provider.AuthCodeURL(state, oauth.CodeChallenge(st.CodeVerifier), st.Nonce)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "provider unavailable: %v", err)
+	}
+
+	data, _ := json.Marshal(st)
+	if err := s.Redis.Set(ctx, oauthStateKey(state), data, s.OAuthStateTTL).Err(); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to store state: %v", err)
+	}
+
+	return &pb.OAuthStartResponse{AuthorizationUrl: url, State: state}, nil
+}
+
+// OAuthCallback menukar code dari provider, menghubungkan identitas ke user lokal,
+// lalu menerbitkan token seperti Login biasa.
+func (s *AuthServer) OAuthCallback(ctx context.Context, in *pb.OAuthCallbackRequest) (*pb.LoginResponse, error) {
+	if in.Code == "" || in.State == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code and state are required")
+	}
+
+	raw, err := s.Redis.GetDel(ctx, oauthStateKey(in.State)).Result()
+	if err == redis.Nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid or expired state")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "state check failed: %v", err)
+	}
+	var st oauthState
+	if err := json.Unmarshal([]byte(raw), &st); err != nil || st.Provider != in.Provider {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid state")
+	}
+
+	provider, ok := s.OAuth.Get(st.Provider)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown provider %q", st.Provider)
+	}
+
+	identity, err := provider.Exchange(ctx, in.Code, st.CodeVerifier, st.Nonce)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "provider login failed: %v", err)
+	}
+
+	user, err := s.linkIdentity(ctx, provider.Name(), identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNotSuspended(user); err != nil {
+		return nil, err
+	}
+
+	if s.requiresMFA(user) {
+		return s.mfaChallenge(user)
+	}
+
+	resp, _, err := s.issueTokens(s.DB.WithContext(ctx), user, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue tokens: %v", err)
+	}
+	return resp, nil
+}
+
+// linkIdentity mencari user lewat identitas yang sudah terhubung, lalu lewat email
+// yang sudah diverifikasi provider. Kalau belum ada, user baru dibuat. Akun lokal yang
+// emailnya belum diverifikasi diambil alih pemilik email: kredensial lama direset.
+func (s *AuthServer) linkIdentity(ctx context.Context, provider string, id *oauth.Identity) (*model.User, error) {
+	db := s.DB.WithContext(ctx)
+
+	var link model.UserIdentity
+	err := db.Where("provider = ? AND subject = ?", provider, id.Subject).First(&link).Error
+	if err == nil {
+		var user model.User
+		if err := db.First(&user, link.UserID).Error; err != nil {
+			return nil, status.Errorf(codes.Internal, "linked user not found: %v", err)
+		}
+		return &user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	// email yang belum diverifikasi provider tidak boleh dipakai untuk mengambil alih akun lokal
+	if id.Email == "" || !id.EmailVerified {
+		return nil, status.Errorf(codes.PermissionDenied, "provider did not return a verified email")
+	}
+
+	var (
+		user    model.User
+		created bool
+		claimed bool
+	)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("LOWER(email) = ?", normalizeEmail(id.Email)).First(&user).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			user, err = newOAuthUser(id)
+			if err != nil {
+				return err
+			}
+			if err := tx.Create(&user).Error; err != nil {
+				return err
+			}
+			created = true
+			if err := enqueueUserCreated(tx, &user); err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		} else if !user.EmailVerified {
+			// provider sudah memverifikasi email yang sama. Akun lokal yang belum diverifikasi
+			// bisa saja didaftarkan orang lain lebih dulu (pre-hijacking), jadi semua kredensial
+			// lamanya dibuang: password, 2FA, recovery code dan API key.
+			if err := resetUnverifiedCredentials(tx, &user); err != nil {
+				return err
+			}
+			claimed = true
+			// user.created yang tertahan menunggu verifikasi
+			if s.RequireEmailVerification {
+				if err := enqueueUserCreated(tx, &user); err != nil {
+					return err
+				}
+			}
+		}
+
+		return tx.Create(&model.UserIdentity{
+			UserID:   user.ID,
+			Provider: provider,
+			Subject:  id.Subject,
+			Email:    id.Email,
+		}).Error
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to link identity: %v", err)
+	}
+
+	if created {
+		log.Printf("user %d created from %s login", user.ID, provider)
+	}
+	if claimed {
+		// sesi yang dibuat sebelum email diklaim ikut dicabut
+		if _, err := s.revokeAllSessions(ctx, user.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %v", err)
+		}
+		log.Printf("unverified user %d claimed through %s login, old credentials reset", user.ID, provider)
+	}
+	return &user, nil
+}
+
+// resetUnverifiedCredentials mengganti password dengan password acak (user bisa memakai
+// reset password), mematikan 2FA, menghapus recovery code, mencabut API key,
+// lalu menandai email terverifikasi.
+func resetUnverifiedCredentials(tx *gorm.DB, user *model.User) error {
+	hashed, err := randomPasswordHash()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if err := tx.Model(user).Updates(map[string]interface{}{
+		"password":          hashed,
+		"totp_secret":       "",
+		"totp_enabled":      false,
+		"totp_last_step":    0,
+		"email_verified":    true,
+		"email_verified_at": now,
+	}).Error; err != nil {
+		return err
+	}
+	user.Password, user.TOTPSecret, user.TOTPEnabled, user.TOTPLastStep = hashed, "", false, 0
+	user.EmailVerified, user.EmailVerifiedAt = true, &now
+
+	if err := tx.Where("user_id = ?", user.ID).Delete(&model.RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	return tx.Model(&model.APIKey{}).
+		Where("user_id = ? AND revoked_at IS NULL", user.ID).
+		Update("revoked_at", now).Error
+}
+
+// password acak yang tidak diketahui siapa pun; user bisa memakai reset password kalau perlu
+func randomPasswordHash() (string, error) {
+	random, err := oauth.RandomString()
+	if err != nil {
+		return "", err
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(random), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+func newOAuthUser(id *oauth.Identity) (model.User, error) {
+	hashed, err := randomPasswordHash()
+	if err != nil {
+		return model.User{}, err
+	}
+
+	name := id.Name
+	if name == "" {
+		name = strings.Split(id.Email, "@")[0]
+	}
+	return model.User{
+		Email:         id.Email,
+		Password:      hashed,
+		Name:          name,
+		Role:          roleUser,
+		EmailVerified: true,
+	}, nil
+}
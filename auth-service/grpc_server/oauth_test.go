@@ -0,0 +1,310 @@
+package grpc_server
+
+import (
+	"auth-service/keys"
+	"auth-service/model"
+	"auth-service/oauth"
+	"auth-service/oauth/oauthtest"
+	pb "auth-service/proto/auth"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/glebarez/sqlite"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const testProvider = "test"
+
+// newOAuthTestServer menyiapkan AuthServer dengan sqlite in-memory, miniredis
+// dan issuer OIDC palsu.
+func newOAuthTestServer(t *testing.T) (*AuthServer, *oauthtest.Issuer, *miniredis.Miniredis) {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1) // tiap koneksi :memory: punya database sendiri
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := db.AutoMigrate(&model.User{}, &model.RefreshToken{}, &model.SigningKey{}, &model.RecoveryCode{}, &model.UserIdentity{}, &model.APIKey{}, &model.Permission{}, &model.Role{}, &model.OutboxEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SeedRoles(db); err != nil {
+		t.Fatal(err)
+	}
+	km, err := keys.NewManager(db, time.Hour, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	iss, err := oauthtest.NewIssuer("auth-service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(iss.Close)
+
+	s := &AuthServer{
+		DB:              db,
+		Keys:            km,
+		Issuer:          "auth-service",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: time.Hour,
+		OAuth: oauth.NewRegistry(oauth.NewOIDCProvider(oauth.OIDCConfig{
+			Name:        testProvider,
+			IssuerURL:   iss.URL,
+			ClientID:    "auth-service",
+			RedirectURL: "http://localhost/auth/oauth/test/callback",
+		})),
+		OAuthStateTTL: 10 * time.Minute,
+		Redis:         rdb,
+	}
+	return s, iss, mr
+}
+
+// oauthAuthorize menjalankan OAuthStart lalu login di issuer; mengembalikan state dan code.
+func oauthAuthorize(t *testing.T, s *AuthServer, iss *oauthtest.Issuer, claims jwt.MapClaims) (string, string) {
+	t.Helper()
+	start, err := s.OAuthStart(context.Background(), &pb.OAuthStartRequest{Provider: testProvider})
+	if err != nil {
+		t.Fatalf("OAuthStart: %v", err)
+	}
+	code, err := iss.Authorize(start.AuthorizationUrl, claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return start.State, code
+}
+
+func oauthLogin(t *testing.T, s *AuthServer, iss *oauthtest.Issuer, claims jwt.MapClaims) (*pb.LoginResponse, error) {
+	t.Helper()
+	state, code := oauthAuthorize(t, s, iss, claims)
+	return s.OAuthCallback(context.Background(), &pb.OAuthCallbackRequest{Provider: testProvider, Code: code, State: state})
+}
+
+func createTestUser(t *testing.T, db *gorm.DB, user model.User, password string) model.User {
+	t.Helper()
+	hashed, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	user.Password = string(hashed)
+	if user.Role == "" {
+		user.Role = roleUser
+	}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if status.Code(err) != code {
+		t.Fatalf("got error %v, want code %s", err, code)
+	}
+}
+
+func TestOAuthCallbackLinksVerifiedEmail(t *testing.T) {
+	s, iss, _ := newOAuthTestServer(t)
+	user := createTestUser(t, s.DB, model.User{Email: "alice@example.com", Name: "Alice", EmailVerified: true}, "secret-password")
+
+	resp, err := oauthLogin(t, s, iss, jwt.MapClaims{"sub": "alice-sub", "email": "Alice@Example.com", "email_verified": true})
+	if err != nil {
+		t.Fatalf("OAuthCallback: %v", err)
+	}
+	if resp.AccessToken == "" || resp.RefreshToken == "" {
+		t.Fatalf("expected tokens, got %+v", resp)
+	}
+
+	var link model.UserIdentity
+	if err := s.DB.Where("provider = ? AND subject = ?", testProvider, "alice-sub").First(&link).Error; err != nil {
+		t.Fatalf("identity not linked: %v", err)
+	}
+	if link.UserID != user.ID {
+		t.Fatalf("identity linked to user %d, want %d", link.UserID, user.ID)
+	}
+
+	// akun yang sudah terverifikasi tidak diubah: password lama tetap berlaku
+	var got model.User
+	s.DB.First(&got, user.ID)
+	if bcrypt.CompareHashAndPassword([]byte(got.Password), []byte("secret-password")) != nil {
+		t.Fatal("password of verified account was changed")
+	}
+
+	// login berikutnya dicari lewat subject, walaupun email di provider sudah berubah
+	if _, err := oauthLogin(t, s, iss, jwt.MapClaims{"sub": "alice-sub", "email": "alice@new.example.com"}); err != nil {
+		t.Fatalf("second login: %v", err)
+	}
+	var n int64
+	s.DB.Model(&model.User{}).Count(&n)
+	if n != 1 {
+		t.Fatalf("expected no new user, have %d users", n)
+	}
+}
+
+func TestOAuthCallbackCreatesUser(t *testing.T) {
+	s, iss, _ := newOAuthTestServer(t)
+
+	if _, err := oauthLogin(t, s, iss, jwt.MapClaims{"sub": "bob-sub", "email": "bob@example.com", "email_verified": true, "name": "Bob"}); err != nil {
+		t.Fatalf("OAuthCallback: %v", err)
+	}
+
+	var user model.User
+	if err := s.DB.Where("email = ?", "bob@example.com").First(&user).Error; err != nil {
+		t.Fatalf("user not created: %v", err)
+	}
+	if !user.EmailVerified || user.Name != "Bob" || user.Role != roleUser {
+		t.Fatalf("unexpected user %+v", user)
+	}
+	var events int64
+	s.DB.Model(&model.OutboxEvent{}).Where("topic = ? AND key = ?", "user.created", "1").Count(&events)
+	if events != 1 {
+		t.Fatalf("expected one user.created outbox event, got %d", events)
+	}
+}
+
+func TestOAuthCallbackRejectsUnverifiedEmail(t *testing.T) {
+	s, iss, _ := newOAuthTestServer(t)
+	user := createTestUser(t, s.DB, model.User{Email: "carol@example.com", EmailVerified: true}, "secret-password")
+
+	for _, verified := range []interface{}{false, "false", nil} {
+		claims := jwt.MapClaims{"sub": "carol-sub", "email": "carol@example.com"}
+		if verified != nil {
+			claims["email_verified"] = verified
+		}
+		_, err := oauthLogin(t, s, iss, claims)
+		wantCode(t, err, codes.PermissionDenied)
+	}
+
+	var links int64
+	s.DB.Model(&model.UserIdentity{}).Where("user_id = ?", user.ID).Count(&links)
+	if links != 0 {
+		t.Fatalf("unverified email must not be linked, got %d identities", links)
+	}
+}
+
+func TestOAuthCallbackResetsClaimedUnverifiedAccount(t *testing.T) {
+	s, iss, mr := newOAuthTestServer(t)
+	ctx := context.Background()
+
+	// akun didaftarkan lebih dulu oleh orang lain dengan email korban (belum diverifikasi)
+	user := createTestUser(t, s.DB, model.User{
+		Email:       "dave@example.com",
+		TOTPSecret:  "JBSWY3DPEHPK3PXP",
+		TOTPEnabled: true,
+	}, "attacker-password")
+	s.DB.Create(&model.RecoveryCode{UserID: user.ID, CodeHash: hashToken("aaaaa-bbbbb")})
+	s.DB.Create(&model.APIKey{Name: "attacker", Prefix: "ak_test", KeyHash: hashToken("ak_test_key"), UserID: user.ID})
+	oldRefresh, _, err := s.createRefreshToken(s.DB, user.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := oauthLogin(t, s, iss, jwt.MapClaims{"sub": "dave-sub", "email": "dave@example.com", "email_verified": true})
+	if err != nil {
+		t.Fatalf("OAuthCallback: %v", err)
+	}
+	// 2FA milik pendaftar sebelumnya sudah dimatikan, jadi tidak ada challenge
+	if resp.MfaRequired || resp.AccessToken == "" {
+		t.Fatalf("expected tokens without MFA challenge, got %+v", resp)
+	}
+
+	var got model.User
+	s.DB.First(&got, user.ID)
+	if bcrypt.CompareHashAndPassword([]byte(got.Password), []byte("attacker-password")) == nil {
+		t.Fatal("old password still works after the account was claimed")
+	}
+	if !got.EmailVerified || got.EmailVerifiedAt == nil {
+		t.Fatal("claimed account is not marked verified")
+	}
+	if got.TOTPEnabled || got.TOTPSecret != "" {
+		t.Fatal("2FA of the claimed account was not reset")
+	}
+
+	var codesLeft, activeKeys int64
+	s.DB.Model(&model.RecoveryCode{}).Where("user_id = ?", user.ID).Count(&codesLeft)
+	s.DB.Model(&model.APIKey{}).Where("user_id = ? AND revoked_at IS NULL", user.ID).Count(&activeKeys)
+	if codesLeft != 0 || activeKeys != 0 {
+		t.Fatalf("recovery codes left %d, active api keys %d", codesLeft, activeKeys)
+	}
+
+	var rt model.RefreshToken
+	s.DB.Where("token_hash = ?", hashToken(oldRefresh)).First(&rt)
+	if rt.RevokedAt == nil {
+		t.Fatal("refresh token issued before the claim is still active")
+	}
+	if !mr.Exists(revokedUserKey(user.ID)) {
+		t.Fatal("access tokens issued before the claim were not revoked")
+	}
+
+	// token baru dari login OAuth tidak ikut tercabut
+	revoked, err := s.isRevoked(ctx, "", user.ID, time.Now().Add(time.Second).UnixMicro())
+	if err != nil || revoked {
+		t.Fatalf("new session revoked: %v %v", revoked, err)
+	}
+}
+
+func TestOAuthStateSingleUse(t *testing.T) {
+	s, iss, _ := newOAuthTestServer(t)
+	claims := jwt.MapClaims{"sub": "erin-sub", "email": "erin@example.com", "email_verified": true}
+
+	state, code := oauthAuthorize(t, s, iss, claims)
+	if _, err := s.OAuthCallback(context.Background(), &pb.OAuthCallbackRequest{Provider: testProvider, Code: code, State: state}); err != nil {
+		t.Fatalf("first callback: %v", err)
+	}
+
+	// state yang sama dengan code baru tetap ditolak
+	_, code2 := oauthAuthorize(t, s, iss, claims)
+	_, err := s.OAuthCallback(context.Background(), &pb.OAuthCallbackRequest{Provider: testProvider, Code: code2, State: state})
+	wantCode(t, err, codes.Unauthenticated)
+}
+
+func TestOAuthStateExpires(t *testing.T) {
+	s, iss, mr := newOAuthTestServer(t)
+
+	state, code := oauthAuthorize(t, s, iss, jwt.MapClaims{"sub": "frank-sub", "email": "frank@example.com", "email_verified": true})
+	mr.FastForward(s.OAuthStateTTL + time.Second)
+
+	_, err := s.OAuthCallback(context.Background(), &pb.OAuthCallbackRequest{Provider: testProvider, Code: code, State: state})
+	wantCode(t, err, codes.Unauthenticated)
+}
+
+func TestOAuthCallbackRejectsInvalidIDToken(t *testing.T) {
+	for name, claims := range map[string]jwt.MapClaims{
+		"nonce mismatch":    {"nonce": "another-nonce"},
+		"audience mismatch": {"aud": "another-client"},
+		"issuer mismatch":   {"iss": "https://evil.example.com"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s, iss, _ := newOAuthTestServer(t)
+			claims["sub"], claims["email"], claims["email_verified"] = "grace-sub", "grace@example.com", true
+
+			_, err := oauthLogin(t, s, iss, claims)
+			wantCode(t, err, codes.Unauthenticated)
+
+			var n int64
+			s.DB.Model(&model.User{}).Count(&n)
+			if n != 0 {
+				t.Fatalf("user created from rejected id_token")
+			}
+		})
+	}
+}
+
+func TestOAuthCallbackRejectsStateOfAnotherProvider(t *testing.T) {
+	s, iss, _ := newOAuthTestServer(t)
+	state, code := oauthAuthorize(t, s, iss, jwt.MapClaims{"sub": "heidi-sub", "email": "heidi@example.com", "email_verified": true})
+
+	_, err := s.OAuthCallback(context.Background(), &pb.OAuthCallbackRequest{Provider: "google", Code: code, State: state})
+	wantCode(t, err, codes.Unauthenticated)
+}
@@ -0,0 +1,126 @@
+package grpc_server
+
+import (
+	"auth-service/kafka"
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const minPasswordLength = 8
+
+// response sama untuk email terdaftar maupun tidak, supaya tidak bisa dipakai untuk enumerasi akun
+const passwordResetRequestedMessage = "if the email is registered, a reset link has been sent"
+
+// RequestPasswordReset membuat token reset sekali pakai dan mengirim event ke mailer.
+func (s *AuthServer) RequestPasswordReset(ctx context.Context, in *pb.RequestPasswordResetRequest) (*pb.PasswordResetResponse, error) {
+	email := strings.TrimSpace(in.Email)
+	if email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+
+	var user model.User
+	err := s.DB.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pb.PasswordResetResponse{Message: passwordResetRequestedMessage}, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	raw, err := newOpaqueToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+	expiresAt := time.Now().Add(s.PasswordResetTTL)
+
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// token lama yang belum dipakai langsung tidak berlaku
+		if err := tx.Model(&model.PasswordResetToken{}).
+			Where("user_id = ? AND used_at IS NULL", user.ID).
+			Update("used_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.PasswordResetToken{
+			UserID:    user.ID,
+			TokenHash: hashToken(raw),
+			ExpiresAt: expiresAt,
+		}).Error
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store reset token: %v", err)
+	}
+
+	go kafka.PublishPasswordResetRequestedEvent(map[string]interface{}{
+		"user_id":    user.ID,
+		"email":      user.Email,
+		"name":       user.Name,
+		"token":      raw,
+		"expires_at": expiresAt,
+	})
+
+	return &pb.PasswordResetResponse{Message: passwordResetRequestedMessage}, nil
+}
+
+// ConfirmPasswordReset mengganti password memakai token reset lalu mencabut semua sesi user.
+func (s *AuthServer) ConfirmPasswordReset(ctx context.Context, in *pb.ConfirmPasswordResetRequest) (*pb.PasswordResetResponse, error) {
+	if in.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+	if len(in.NewPassword) < minPasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "password must be at least %d characters", minPasswordLength)
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(in.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
+	}
+
+	var userID uint
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var rt model.PasswordResetToken
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ?", hashToken(in.Token)).
+			First(&rt).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.InvalidArgument, "invalid or expired reset token")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "query error: %v", err)
+		}
+		if rt.UsedAt != nil || time.Now().After(rt.ExpiresAt) {
+			return status.Errorf(codes.InvalidArgument, "invalid or expired reset token")
+		}
+
+		if err := tx.Model(&model.User{}).Where("id = ?", rt.UserID).
+			Update("password", string(hashed)).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to update password: %v", err)
+		}
+		if err := tx.Model(&rt).Update("used_at", time.Now()).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to consume token: %v", err)
+		}
+
+		userID = rt.UserID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.revokeAllSessions(ctx, userID); err != nil {
+		// password sudah terganti, jangan gagalkan request; cukup dicatat
+		log.Printf("failed to revoke sessions after password reset (user %d): %v", userID, err)
+	}
+
+	return &pb.PasswordResetResponse{Message: "password has been reset"}, nil
+}
@@ -0,0 +1,244 @@
+package grpc_server
+
+import (
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// Katalog permission. Nama permission dipakai langsung oleh middleware.RequirePermission
+// di tiap service, jadi permission baru ditambahkan di sini dulu.
+var permissionCatalog = map[string]string{
+	"user:read_all":        "list and search all users",
+	"address:read_all":     "list and search all addresses",
+	"cart:read_all":        "list all carts",
+	"product:write":        "create, update and delete products",
+	"category:write":       "create, update and delete categories",
+	"stock:read":           "view product stock",
+	"stock:write":          "update product stock levels",
+	"payment:read_all":     "list all payments",
+	"transaction:read_all": "list all transactions",
+	"session:revoke":       "revoke user sessions",
+	"account:unlock":       "unlock accounts locked after failed logins",
+	"apikey:write":         "create, list and revoke API keys",
+	"role:write":           "manage roles and assign them to users",
+	"user:suspend":         "suspend and reactivate user accounts",
+	"user:impersonate":     "view the storefront as a customer (read-only)",
+}
+
+// role bawaan yang dibuat saat startup kalau belum ada
+var builtinRoles = map[string]struct {
+	description string
+	permissions []string
+}{
+	roleUser:  {"default role for registered customers", nil},
+	roleAdmin: {"full access", nil}, // selalu disinkronkan dengan seluruh katalog
+	"catalog_manager": {"manages products, categories and stock", []string{
+		"product:write", "category:write", "stock:read", "stock:write",
+	}},
+	"finance": {"views payments and transactions", []string{
+		"payment:read_all", "transaction:read_all",
+	}},
+	"ops": {"customer support and operations", []string{
+		"user:read_all", "address:read_all", "cart:read_all", "session:revoke", "account:unlock",
+		"user:suspend", "user:impersonate",
+	}},
+}
+
+// SeedRoles memastikan katalog permission dan role bawaan ada di DB.
+func SeedRoles(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		all := make([]model.Permission, 0, len(permissionCatalog))
+		added := map[string]bool{} // permission yang baru masuk katalog pada startup ini
+		for name, desc := range permissionCatalog {
+			var n int64
+			if err := tx.Model(&model.Permission{}).Where("name = ?", name).Count(&n).Error; err != nil {
+				return err
+			}
+			added[name] = n == 0
+
+			p := model.Permission{Name: name}
+			if err := tx.Where(model.Permission{Name: name}).
+				Assign(model.Permission{Description: desc}).
+				FirstOrCreate(&p).Error; err != nil {
+				return err
+			}
+			all = append(all, p)
+		}
+
+		for name, def := range builtinRoles {
+			var role model.Role
+			err := tx.Where("name = ?", name).First(&role).Error
+			if err == nil && name != roleAdmin {
+				// role yang sudah ada mungkin sudah diubah admin, jangan ditimpa;
+				// cukup tambahkan permission bawaan yang baru masuk katalog
+				var fresh []string
+				for _, p := range def.permissions {
+					if added[p] {
+						fresh = append(fresh, p)
+					}
+				}
+				if len(fresh) == 0 {
+					continue
+				}
+				var perms []model.Permission
+				if err := tx.Where("name IN ?", fresh).Find(&perms).Error; err != nil {
+					return err
+				}
+				if err := tx.Model(&role).Association("Permissions").Append(perms); err != nil {
+					return err
+				}
+				continue
+			}
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				role = model.Role{Name: name, Description: def.description}
+				if err := tx.Create(&role).Error; err != nil {
+					return err
+				}
+			} else if err != nil {
+				return err
+			}
+
+			perms := all
+			if name != roleAdmin {
+				if err := tx.Where("name IN ?", def.permissions).Find(&perms).Error; err != nil {
+					return err
+				}
+			}
+			if err := tx.Model(&role).Association("Permissions").Replace(perms); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// permissionsForRole mengembalikan nama permission milik role (terurut), untuk dimasukkan ke token.
+func permissionsForRole(db *gorm.DB, roleName string) ([]string, error) {
+	var role model.Role
+	err := db.Preload("Permissions").Where("name = ?", roleName).First(&role).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return permissionNames(role.Permissions), nil
+}
+
+// ListRoles (admin) mengembalikan semua role beserta permission-nya.
+func (s *AuthServer) ListRoles(ctx context.Context, in *pb.ListRolesRequest) (*pb.ListRolesResponse, error) {
+	var roles []model.Role
+	if err := s.DB.WithContext(ctx).Preload("Permissions").Order("name").Find(&roles).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	res := &pb.ListRolesResponse{}
+	for _, r := range roles {
+		res.Roles = append(res.Roles, toRoleInfo(&r))
+	}
+	for name := range permissionCatalog {
+		res.AvailablePermissions = append(res.AvailablePermissions, name)
+	}
+	sort.Strings(res.AvailablePermissions)
+
+	return res, nil
+}
+
+// UpsertRole (admin) membuat role baru atau mengganti permission role yang sudah ada.
+// Kalau ada permission yang dicabut dari role, sesi semua pemegang role ikut dicabut.
+func (s *AuthServer) UpsertRole(ctx context.Context, in *pb.UpsertRoleRequest) (*pb.RoleInfo, error) {
+	name := strings.ToLower(strings.TrimSpace(in.Name))
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if name == roleAdmin {
+		return nil, status.Errorf(codes.FailedPrecondition, "role %q always has every permission", roleAdmin)
+	}
+	for _, p := range in.Permissions {
+		if _, ok := permissionCatalog[p]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown permission %q", p)
+		}
+	}
+
+	var role model.Role
+	shrunk := false
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Preload("Permissions").Where("name = ?", name).First(&role).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			role = model.Role{Name: name, Description: in.Description}
+			err = tx.Create(&role).Error
+		} else if err == nil && in.Description != "" {
+			err = tx.Model(&role).Update("description", in.Description).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		var perms []model.Permission
+		if len(in.Permissions) > 0 {
+			if err := tx.Where("name IN ?", in.Permissions).Find(&perms).Error; err != nil {
+				return err
+			}
+		}
+		kept := map[string]bool{}
+		for _, p := range perms {
+			kept[p.Name] = true
+		}
+		for _, p := range role.Permissions {
+			if !kept[p.Name] {
+				shrunk = true
+			}
+		}
+
+		if err := tx.Model(&role).Association("Permissions").Replace(perms); err != nil {
+			return err
+		}
+		role.Permissions = perms
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save role: %v", err)
+	}
+
+	if shrunk {
+		var userIDs []uint
+		if err := s.DB.WithContext(ctx).Model(&model.User{}).Where("role = ?", name).Pluck("id", &userIDs).Error; err != nil {
+			log.Printf("failed to list users of role %s: %v", name, err)
+		}
+		for _, id := range userIDs {
+			if _, err := s.revokeAllSessions(ctx, id); err != nil {
+				log.Printf("failed to revoke sessions after role change (user %d): %v", id, err)
+			}
+		}
+	}
+
+	return toRoleInfo(&role), nil
+}
+
+// ====================== HELPER ======================
+
+func permissionNames(perms []model.Permission) []string {
+	names := make([]string, 0, len(perms))
+	for _, p := range perms {
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func toRoleInfo(role *model.Role) *pb.RoleInfo {
+	return &pb.RoleInfo{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissionNames(role.Permissions),
+	}
+}
@@ -0,0 +1,43 @@
+package grpc_server
+
+import (
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultListUsersLimit = 500
+	maxListUsersLimit     = 1000
+)
+
+// ListUsers mengembalikan user yang seharusnya sudah direplikasi ke user-service
+// (sama dengan syarat user.created dikirim), diurutkan berdasarkan id.
+func (s *AuthServer) ListUsers(ctx context.Context, in *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultListUsersLimit
+	}
+	if limit > maxListUsersLimit {
+		limit = maxListUsersLimit
+	}
+
+	q := s.DB.WithContext(ctx).Where("id > ?", in.AfterId)
+	if s.RequireEmailVerification {
+		q = q.Where("email_verified = ?", true)
+	}
+
+	var users []model.User
+	if err := q.Order("id").Limit(limit).Find(&users).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+
+	resp := &pb.ListUsersResponse{}
+	for i := range users {
+		resp.Users = append(resp.Users, toAuthResponse(&users[i]))
+	}
+	return resp, nil
+}
@@ -0,0 +1,85 @@
+package grpc_server
+
+import (
+	"auth-service/model"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Denylist di Redis:
+//
+//	auth:revoked:jti:<jti>   -> access token tertentu dicabut (TTL = sisa umur token)
+//	auth:revoked:user:<id>   -> semua token user dengan iat <= nilai ini (mikrodetik unix) dicabut
+func revokedJTIKey(jti string) string {
+	return fmt.Sprintf("auth:revoked:jti:%s", jti)
+}
+
+func revokedUserKey(userID uint) string {
+	return fmt.Sprintf("auth:revoked:user:%d", userID)
+}
+
+// revokeAccessToken memasukkan jti ke denylist sampai token tersebut expired.
+func (s *AuthServer) revokeAccessToken(ctx context.Context, jti string, exp time.Time) error {
+	ttl := time.Until(exp)
+	if jti == "" || ttl <= 0 {
+		return nil
+	}
+	return s.Redis.Set(ctx, revokedJTIKey(jti), 1, ttl).Err()
+}
+
+// revokeAllSessions mencabut semua access token yang sudah terbit dan semua refresh token milik user.
+func (s *AuthServer) revokeAllSessions(ctx context.Context, userID uint) (int64, error) {
+	cutoff := time.Now().UnixMicro()
+	// setelah umur token terpanjang semua token lama pasti sudah expired, key boleh hilang
+	if err := s.Redis.Set(ctx, revokedUserKey(userID), cutoff, s.maxTokenTTL()).Err(); err != nil {
+		return 0, err
+	}
+
+	res := s.DB.WithContext(ctx).Model(&model.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now())
+	return res.RowsAffected, res.Error
+}
+
+// isRevoked mengecek jti dan cutoff user di denylist.
+// issuedAt dalam mikrodetik unix (claimMicros).
+func (s *AuthServer) isRevoked(ctx context.Context, jti string, userID uint, issuedAt int64) (bool, error) {
+	if jti != "" {
+		n, err := s.Redis.Exists(ctx, revokedJTIKey(jti)).Result()
+		if err != nil {
+			return false, err
+		}
+		if n > 0 {
+			return true, nil
+		}
+	}
+
+	val, err := s.Redis.Get(ctx, revokedUserKey(userID)).Result()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	cutoff, _ := strconv.ParseInt(val, 10, 64)
+	if cutoff < 1e12 {
+		// cutoff lama masih dalam detik: cabut semua token sampai akhir detik tersebut
+		cutoff = cutoff*1e6 + 999999
+	}
+	return issuedAt <= cutoff, nil
+}
+
+// maxTokenTTL adalah umur terpanjang token yang dicek isRevoked (access, impersonation, challenge 2FA).
+func (s *AuthServer) maxTokenTTL() time.Duration {
+	ttl := s.AccessTokenTTL
+	for _, d := range []time.Duration{s.ImpersonationTTL, s.MFAChallengeTTL} {
+		if d > ttl {
+			ttl = d
+		}
+	}
+	return ttl
+}
@@ -0,0 +1,149 @@
+package grpc_server
+
+import (
+	"auth-service/kafka"
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	roleUser  = "user"
+	roleAdmin = "admin"
+)
+
+// AssignRole (admin) memberikan role ke user. Permission baru berlaku saat user login / refresh berikutnya.
+func (s *AuthServer) AssignRole(ctx context.Context, in *pb.RoleChangeRequest) (*pb.RoleChangeResponse, error) {
+	var role model.Role
+	if err := s.DB.WithContext(ctx).Where("name = ?", in.Role).First(&role).Error; err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown role %q", in.Role)
+	}
+
+	var previous string
+	user, err := s.changeRole(ctx, in, "assign", func(current string) (string, error) {
+		previous = current
+		return in.Role, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// role baru dengan permission lebih sedikit (mis. admin -> user): token lama harus dicabut
+	if shrunk, err := s.permissionsShrunk(previous, in.Role); err != nil || shrunk {
+		if _, err := s.revokeAllSessions(ctx, user.ID); err != nil {
+			log.Printf("failed to revoke sessions after role change (user %d): %v", user.ID, err)
+		}
+	}
+
+	return &pb.RoleChangeResponse{Message: "role assigned", User: toAuthResponse(user)}, nil
+}
+
+// RevokeRole (admin) mencabut role dari user dan mengembalikannya ke role default.
+// Sesi user ikut dicabut supaya token lama yang membawa role tersebut tidak bisa dipakai lagi.
+func (s *AuthServer) RevokeRole(ctx context.Context, in *pb.RoleChangeRequest) (*pb.RoleChangeResponse, error) {
+	if in.Role == roleUser {
+		return nil, status.Errorf(codes.InvalidArgument, "role %q cannot be revoked", roleUser)
+	}
+
+	user, err := s.changeRole(ctx, in, "revoke", func(current string) (string, error) {
+		if current != in.Role {
+			return "", status.Errorf(codes.FailedPrecondition, "user does not have role %q", in.Role)
+		}
+		return roleUser, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.revokeAllSessions(ctx, user.ID); err != nil {
+		log.Printf("failed to revoke sessions after role revoke (user %d): %v", user.ID, err)
+	}
+
+	return &pb.RoleChangeResponse{Message: "role revoked", User: toAuthResponse(user)}, nil
+}
+
+// permissionsShrunk true kalau role "to" tidak punya salah satu permission milik role "from".
+func (s *AuthServer) permissionsShrunk(from, to string) (bool, error) {
+	if from == to {
+		return false, nil
+	}
+	before, err := permissionsForRole(s.DB, from)
+	if err != nil {
+		return false, err
+	}
+	after, err := permissionsForRole(s.DB, to)
+	if err != nil {
+		return false, err
+	}
+
+	kept := map[string]bool{}
+	for _, p := range after {
+		kept[p] = true
+	}
+	for _, p := range before {
+		if !kept[p] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// changeRole mengubah role user, menulis audit log, lalu mengirim event user.updated.
+func (s *AuthServer) changeRole(ctx context.Context, in *pb.RoleChangeRequest, action string, next func(current string) (string, error)) (*model.User, error) {
+	var user model.User
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, in.UserId).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "user not found")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "query error: %v", err)
+		}
+
+		newRole, err := next(user.Role)
+		if err != nil {
+			return err
+		}
+
+		audit := model.RoleAuditLog{
+			UserID:  user.ID,
+			ActorID: uint(in.ActorId),
+			Action:  action,
+			OldRole: user.Role,
+			NewRole: newRole,
+		}
+		if err := tx.Create(&audit).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to write audit log: %v", err)
+		}
+		if err := tx.Model(&user).Update("role", newRole).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to update role: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go kafka.PublishUserUpdatedEvent(toAuthResponse(&user))
+
+	return &user, nil
+}
+
+func toAuthResponse(user *model.User) *pb.AuthResponse {
+	return &pb.AuthResponse{
+		Id:              uint32(user.ID),
+		Email:           user.Email,
+		Name:            user.Name,
+		Role:            user.Role,
+		EmailVerified:   user.EmailVerified,
+		Suspended:       user.SuspendedAt != nil,
+		SuspendedReason: user.SuspendedReason,
+	}
+}
@@ -1,21 +1,47 @@
 package grpc_server
 
 import (
+	"auth-service/keys"
 	"auth-service/model"
+	"auth-service/oauth"
+	"auth-service/outbox"
 	pb "auth-service/proto/auth"
 	"context"
-	"fmt"
+	"errors"
+	"log"
+	"strconv"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type AuthServer struct {
 	pb.UnimplementedAuthServiceServer
-	DB        *gorm.DB
-	JWTSecret string
+	DB               *gorm.DB
+	Keys             *keys.Manager
+	Issuer           string
+	AccessTokenTTL   time.Duration
+	RefreshTokenTTL  time.Duration
+	PasswordResetTTL time.Duration
+	// RequireEmailVerification menolak login akun yang email-nya belum diverifikasi
+	RequireEmailVerification bool
+	EmailVerificationTTL     time.Duration
+	// 2FA
+	MFARequiredRoles map[string]bool
+	MFAChallengeTTL  time.Duration
+	TOTPIssuer       string
+	LoginPolicy      LoginPolicy
+	// login lewat identity provider eksternal
+	OAuth         *oauth.Registry
+	OAuthStateTTL time.Duration
+	// umur token Impersonate (tanpa refresh token)
+	ImpersonationTTL time.Duration
+	Redis            *redis.Client
 }
 
 func (s *AuthServer) Register(ctx context.Context, in *pb.RegisterRequest) (*pb.AuthResponse, error) {
@@ -24,65 +50,233 @@ func (s *AuthServer) Register(ctx context.Context, in *pb.RegisterRequest) (*pb.
 		Email:    in.Email,
 		Password: string(hashed),
 		Name:     in.Name,
-		Role:     in.Role,
+		Role:     roleUser, // role dari client tidak dipercaya, admin diberikan lewat AssignRole
 	}
-	if err := s.DB.Create(&user).Error; err != nil {
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&user).Error; err != nil {
+			return err
+		}
+		// kalau verifikasi wajib, user.created baru dikirim setelah email terverifikasi
+		if s.RequireEmailVerification {
+			return nil
+		}
+		return enqueueUserCreated(tx, &user)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return &pb.AuthResponse{
-		Id:    uint32(user.ID),
-		Email: user.Email,
-		Name:  user.Name,
-		Role:  user.Role,
-	}, nil
+	res := &pb.AuthResponse{
+		Id:            uint32(user.ID),
+		Email:         user.Email,
+		Name:          user.Name,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerified,
+	}
+
+	if err := s.sendVerification(ctx, &user); err != nil {
+		log.Printf("failed to send verification for user %d: %v", user.ID, err)
+	}
+
+	return res, nil
+}
+
+// enqueueUserCreated menulis user.created ke outbox dalam transaksi tx.
+func enqueueUserCreated(tx *gorm.DB, user *model.User) error {
+	return outbox.Enqueue(tx, "user.created", "user_created", strconv.FormatUint(uint64(user.ID), 10), toAuthResponse(user))
 }
 
 func (s *AuthServer) Login(ctx context.Context, in *pb.LoginRequest) (*pb.LoginResponse, error) {
-	var user model.User
-	if err := s.DB.Where("email = ?", in.Email).First(&user).Error; err != nil {
+	email := normalizeEmail(in.Email)
+	if err := s.checkLoginAllowed(ctx, email, in.ClientIp); err != nil {
 		return nil, err
 	}
+
+	var user model.User
+	err := s.DB.WithContext(ctx).Where("LOWER(email) = ?", email).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		s.recordLoginFailure(ctx, email, in.ClientIp, nil, "unknown_email")
+		return nil, status.Errorf(codes.Unauthenticated, "invalid email or password")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(in.Password)); err != nil {
+		s.recordLoginFailure(ctx, email, in.ClientIp, &user, "invalid_password")
+		return nil, status.Errorf(codes.Unauthenticated, "invalid email or password")
+	}
+
+	if err := checkNotSuspended(&user); err != nil {
 		return nil, err
 	}
+	if s.RequireEmailVerification && !user.EmailVerified {
+		return nil, status.Errorf(codes.PermissionDenied, "email not verified")
+	}
+	// counter gagal baru di-reset setelah login selesai; untuk user 2FA itu di VerifyTOTP
+	if s.requiresMFA(&user) {
+		return s.mfaChallenge(&user)
+	}
 
-	claims := jwt.MapClaims{
-		"sub":   user.ID,
-		"email": user.Email,
-		"role":  user.Role,
-		"exp":   time.Now().Add(72 * time.Hour).Unix(),
+	resp, _, err := s.issueTokens(s.DB.WithContext(ctx), &user, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue tokens: %v", err)
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signed, _ := token.SignedString([]byte(s.JWTSecret))
+	s.clearLoginFailures(ctx, email)
 
-	return &pb.LoginResponse{AccessToken: signed}, nil
+	return resp, nil
+}
+
+// RefreshToken menukar refresh token dengan pasangan token baru (rotasi).
+// Token lama langsung dicabut; kalau token yang sudah dicabut dipakai lagi,
+// seluruh family ikut dicabut karena kemungkinan token tersebut bocor.
+func (s *AuthServer) RefreshToken(ctx context.Context, in *pb.RefreshTokenRequest) (*pb.LoginResponse, error) {
+	if in.RefreshToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	var resp *pb.LoginResponse
+	reused := false
+
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current model.RefreshToken
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ?", hashToken(in.RefreshToken)).
+			First(&current).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.Unauthenticated, "invalid refresh token")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "query error: %v", err)
+		}
+
+		if current.RevokedAt != nil {
+			// commit pencabutan family, error dikembalikan di luar transaksi
+			reused = true
+			return revokeFamily(tx, current.FamilyID)
+		}
+		if time.Now().After(current.ExpiresAt) {
+			return status.Errorf(codes.Unauthenticated, "refresh token expired")
+		}
+
+		var user model.User
+		if err := tx.First(&user, current.UserID).Error; err != nil {
+			return status.Errorf(codes.Unauthenticated, "user not found")
+		}
+		if err := checkNotSuspended(&user); err != nil {
+			return err
+		}
+
+		var next *model.RefreshToken
+		resp, next, err = s.issueTokens(tx, &user, current.FamilyID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to issue tokens: %v", err)
+		}
+
+		return tx.Model(&current).Updates(map[string]interface{}{
+			"revoked_at":  time.Now(),
+			"replaced_by": next.ID,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token reuse detected, session revoked")
+	}
+
+	return resp, nil
 }
 
 func (s *AuthServer) ValidateToken(ctx context.Context, in *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
-    token, err := jwt.Parse(in.Token, func(token *jwt.Token) (interface{}, error) {
-        return []byte(s.JWTSecret), nil
-    })
-    if err != nil || !token.Valid {
-        return nil, fmt.Errorf("invalid token")
-    }
-
-    claims, ok := token.Claims.(jwt.MapClaims)
-    if !ok {
-        return nil, fmt.Errorf("invalid claims")
-    }
-
-    sub, ok := claims["sub"].(float64)
-    if !ok {
-        return nil, fmt.Errorf("invalid sub claim")
-    }
-
-    email, _ := claims["email"].(string)
-    role, _ := claims["role"].(string)
-
-    return &pb.ValidateTokenResponse{
-        Id:    uint32(sub),
-        Email: email,
-        Role:  role, // ✅ pastikan ini ada
-    }, nil
-}
\ No newline at end of file
+	if in.ApiKey != "" {
+		return s.validateAPIKey(ctx, in.ApiKey)
+	}
+
+	claims, err := s.parseAccessToken(in.Token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+
+	sub := uint(claimInt64(claims, "sub"))
+	jti, _ := claims["jti"].(string)
+
+	revoked, err := s.isRevoked(ctx, jti, sub, claimMicros(claims, "iat"))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "revocation check failed: %v", err)
+	}
+	if revoked {
+		return nil, status.Errorf(codes.Unauthenticated, "token revoked")
+	}
+
+	suspended, err := s.isSuspended(ctx, sub)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "suspension check failed: %v", err)
+	}
+	if suspended {
+		return nil, status.Errorf(codes.PermissionDenied, "account suspended")
+	}
+
+	email, _ := claims["email"].(string)
+	role, _ := claims["role"].(string)
+
+	return &pb.ValidateTokenResponse{
+		Id:             uint32(sub),
+		Email:          email,
+		Role:           role, // ✅ pastikan ini ada
+		Permissions:    claimStrings(claims, "perms"),
+		ImpersonatorId: uint32(impersonatorID(claims)),
+	}, nil
+}
+
+// Logout mencabut access token yang sedang dipakai (via jti) dan, kalau dikirim,
+// family refresh token-nya.
+func (s *AuthServer) Logout(ctx context.Context, in *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	claims, err := s.parseAccessToken(in.AccessToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+
+	jti, _ := claims["jti"].(string)
+	exp := time.Unix(claimInt64(claims, "exp"), 0)
+	if err := s.revokeAccessToken(ctx, jti, exp); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke token: %v", err)
+	}
+
+	if in.RefreshToken != "" {
+		var rt model.RefreshToken
+		err := s.DB.WithContext(ctx).Where("token_hash = ?", hashToken(in.RefreshToken)).First(&rt).Error
+		if err == nil && rt.UserID == uint(claimInt64(claims, "sub")) {
+			if err := revokeFamily(s.DB.WithContext(ctx), rt.FamilyID); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to revoke refresh token: %v", err)
+			}
+		}
+	}
+
+	return &pb.LogoutResponse{Message: "logged out"}, nil
+}
+
+// RevokeAllSessions (admin) mencabut semua sesi dan API key user, dipakai untuk mengunci akun yang disusupi.
+func (s *AuthServer) RevokeAllSessions(ctx context.Context, in *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsResponse, error) {
+	var user model.User
+	if err := s.DB.WithContext(ctx).First(&user, in.UserId).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	n, err := s.revokeAllSessions(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %v", err)
+	}
+
+	keys := s.DB.WithContext(ctx).Model(&model.APIKey{}).
+		Where("user_id = ? AND revoked_at IS NULL", user.ID).
+		Update("revoked_at", time.Now())
+	if keys.Error != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke api keys: %v", keys.Error)
+	}
+
+	return &pb.RevokeAllSessionsResponse{
+		Message:              "all sessions revoked",
+		RevokedRefreshTokens: n,
+		RevokedApiKeys:       keys.RowsAffected,
+	}, nil
+}
@@ -0,0 +1,160 @@
+package grpc_server
+
+import (
+	"auth-service/kafka"
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// auth:suspended:user:<id> -> user sedang di-suspend (tanpa TTL, dihapus saat reactivate).
+// Dicek ValidateToken dan middleware service lain bersama denylist token.
+func suspendedUserKey(userID uint) string {
+	return fmt.Sprintf("auth:suspended:user:%d", userID)
+}
+
+const maxReasonLength = 500
+
+// checkNotSuspended dipanggil sebelum menerbitkan token apa pun untuk user.
+func checkNotSuspended(user *model.User) error {
+	if user.SuspendedAt != nil {
+		return status.Errorf(codes.PermissionDenied, "account suspended")
+	}
+	return nil
+}
+
+// SuspendUser (admin) menonaktifkan akun: semua sesi dicabut, login dan token baru ditolak
+// sampai akun di-reactivate.
+func (s *AuthServer) SuspendUser(ctx context.Context, in *pb.AccountStatusRequest) (*pb.AccountStatusResponse, error) {
+	if in.UserId == in.ActorId {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot suspend your own account")
+	}
+
+	user, err := s.changeAccountStatus(ctx, in, "suspend", func(tx *gorm.DB, user *model.User, reason string) error {
+		if user.SuspendedAt != nil {
+			return status.Errorf(codes.FailedPrecondition, "user already suspended")
+		}
+		now := time.Now()
+		user.SuspendedAt = &now
+		user.SuspendedReason = reason
+		return tx.Model(user).Updates(map[string]interface{}{
+			"suspended_at":     now,
+			"suspended_reason": reason,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Redis.Set(ctx, suspendedUserKey(user.ID), 1, 0).Err(); err != nil {
+		log.Printf("failed to mark user %d suspended in redis: %v", user.ID, err)
+	}
+	if _, err := s.revokeAllSessions(ctx, user.ID); err != nil {
+		log.Printf("failed to revoke sessions after suspend (user %d): %v", user.ID, err)
+	}
+
+	return &pb.AccountStatusResponse{Message: "user suspended", User: toAuthResponse(user)}, nil
+}
+
+// ReactivateUser (admin) membatalkan suspend. User perlu login ulang karena sesi lamanya sudah dicabut.
+func (s *AuthServer) ReactivateUser(ctx context.Context, in *pb.AccountStatusRequest) (*pb.AccountStatusResponse, error) {
+	user, err := s.changeAccountStatus(ctx, in, "reactivate", func(tx *gorm.DB, user *model.User, reason string) error {
+		if user.SuspendedAt == nil {
+			return status.Errorf(codes.FailedPrecondition, "user is not suspended")
+		}
+		user.SuspendedAt = nil
+		user.SuspendedReason = ""
+		return tx.Model(user).Updates(map[string]interface{}{
+			"suspended_at":     nil,
+			"suspended_reason": "",
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Redis.Del(ctx, suspendedUserKey(user.ID)).Err(); err != nil {
+		log.Printf("failed to clear suspended flag for user %d: %v", user.ID, err)
+	}
+
+	return &pb.AccountStatusResponse{Message: "user reactivated", User: toAuthResponse(user)}, nil
+}
+
+// changeAccountStatus mengunci baris user, menjalankan apply, menulis audit log,
+// lalu mengirim event user.updated.
+func (s *AuthServer) changeAccountStatus(ctx context.Context, in *pb.AccountStatusRequest, action string, apply func(tx *gorm.DB, user *model.User, reason string) error) (*model.User, error) {
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+	if len(reason) > maxReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxReasonLength)
+	}
+
+	var user model.User
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, in.UserId).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "user not found")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "query error: %v", err)
+		}
+
+		if err := apply(tx, &user, reason); err != nil {
+			if _, ok := status.FromError(err); ok {
+				return err
+			}
+			return status.Errorf(codes.Internal, "failed to update user: %v", err)
+		}
+
+		audit := model.AccountAuditLog{
+			UserID:  user.ID,
+			ActorID: uint(in.ActorId),
+			Action:  action,
+			Reason:  reason,
+		}
+		if err := tx.Create(&audit).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to write audit log: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go kafka.PublishUserUpdatedEvent(toAuthResponse(&user))
+
+	return &user, nil
+}
+
+// SyncSuspensions menulis ulang flag suspend di Redis dari DB saat startup,
+// untuk berjaga-jaga kalau Redis kehilangan datanya.
+func (s *AuthServer) SyncSuspensions(ctx context.Context) error {
+	var ids []uint
+	if err := s.DB.WithContext(ctx).Model(&model.User{}).Where("suspended_at IS NOT NULL").Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := s.Redis.Set(ctx, suspendedUserKey(id), 1, 0).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSuspended mengecek flag suspend di Redis.
+func (s *AuthServer) isSuspended(ctx context.Context, userID uint) (bool, error) {
+	n, err := s.Redis.Exists(ctx, suspendedUserKey(userID)).Result()
+	return n > 0, err
+}
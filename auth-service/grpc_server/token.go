@@ -0,0 +1,236 @@
+package grpc_server
+
+import (
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+const (
+	tokenTypeAccess       = "access"
+	tokenTypeMFAChallenge = "mfa_challenge"
+)
+
+// ====================== HELPER ======================
+
+func newOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *AuthServer) signAccessToken(user *model.User) (string, error) {
+	jti, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	perms, err := permissionsForRole(s.DB, user.Role)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":   s.Issuer,
+		"jti":   jti,
+		"sub":   user.ID,
+		"email": user.Email,
+		"role":  user.Role,
+		"perms": perms,
+		"typ":   tokenTypeAccess,
+		"iat":   issuedAt(now),
+		"exp":   now.Add(s.AccessTokenTTL).Unix(),
+	}
+	return s.Keys.Sign(claims)
+}
+
+// signImpersonationToken membuat access token atas nama user untuk staf support.
+// Token tidak membawa permission apa pun, berumur pendek, dan claim "act" berisi
+// id staf sehingga service lain bisa membatasinya ke request read-only.
+func (s *AuthServer) signImpersonationToken(user *model.User, actorID uint) (string, string, error) {
+	jti, err := newOpaqueToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	now := time.Now()
+	token, err := s.Keys.Sign(jwt.MapClaims{
+		"iss":   s.Issuer,
+		"jti":   jti,
+		"sub":   user.ID,
+		"email": user.Email,
+		"role":  user.Role,
+		"perms": []string{},
+		"act":   map[string]interface{}{"sub": actorID},
+		"typ":   tokenTypeAccess,
+		"iat":   issuedAt(now),
+		"exp":   now.Add(s.ImpersonationTTL).Unix(),
+	})
+	return token, jti, err
+}
+
+// impersonatorID mengembalikan id staf dari claim "act", 0 kalau bukan token impersonation.
+func impersonatorID(claims jwt.MapClaims) uint {
+	act, _ := claims["act"].(map[string]interface{})
+	sub, _ := act["sub"].(float64)
+	return uint(sub)
+}
+
+// parseAccessToken memverifikasi signature + exp dan mengembalikan claims.
+func (s *AuthServer) parseAccessToken(tokenStr string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenStr, s.Keys.Keyfunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(s.Issuer),
+	)
+	if err != nil || !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, fmt.Errorf("invalid claims")
+	}
+	if _, ok := claims["sub"].(float64); !ok {
+		return nil, fmt.Errorf("invalid sub claim")
+	}
+	if typ, _ := claims["typ"].(string); typ != tokenTypeAccess {
+		return nil, fmt.Errorf("invalid token type")
+	}
+	return claims, nil
+}
+
+// signChallengeToken membuat token berumur pendek untuk langkah kedua login (2FA).
+// Token ini tidak bisa dipakai sebagai access token karena typ-nya berbeda.
+func (s *AuthServer) signChallengeToken(user *model.User) (string, error) {
+	jti, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	return s.Keys.Sign(jwt.MapClaims{
+		"iss": s.Issuer,
+		"jti": jti,
+		"sub": user.ID,
+		"typ": tokenTypeMFAChallenge,
+		"iat": issuedAt(now),
+		"exp": now.Add(s.MFAChallengeTTL).Unix(),
+	})
+}
+
+func (s *AuthServer) parseChallengeToken(tokenStr string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenStr, s.Keys.Keyfunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(s.Issuer),
+	)
+	if err != nil || !token.Valid {
+		return nil, fmt.Errorf("invalid challenge token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, fmt.Errorf("invalid claims")
+	}
+	if typ, _ := claims["typ"].(string); typ != tokenTypeMFAChallenge {
+		return nil, fmt.Errorf("invalid token type")
+	}
+	if _, ok := claims["sub"].(float64); !ok {
+		return nil, fmt.Errorf("invalid sub claim")
+	}
+	return claims, nil
+}
+
+func claimStrings(claims jwt.MapClaims, key string) []string {
+	raw, _ := claims[key].([]interface{})
+	out := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func claimInt64(claims jwt.MapClaims, key string) int64 {
+	v, _ := claims[key].(float64)
+	return int64(v)
+}
+
+// issuedAt menulis iat dengan presisi mikrodetik (NumericDate boleh pecahan), supaya token
+// yang terbit di detik yang sama setelah revokeAllSessions tidak ikut tercabut.
+func issuedAt(t time.Time) float64 {
+	return float64(t.UnixMicro()) / 1e6
+}
+
+// claimMicros membaca claim NumericDate sebagai mikrodetik unix.
+func claimMicros(claims jwt.MapClaims, key string) int64 {
+	v, _ := claims[key].(float64)
+	return int64(math.Round(v * 1e6))
+}
+
+// createRefreshToken menyimpan refresh token baru (hash-nya saja) di family yang diberikan.
+func (s *AuthServer) createRefreshToken(db *gorm.DB, userID uint, familyID string) (string, *model.RefreshToken, error) {
+	raw, err := newOpaqueToken()
+	if err != nil {
+		return "", nil, err
+	}
+	if familyID == "" {
+		if familyID, err = newOpaqueToken(); err != nil {
+			return "", nil, err
+		}
+	}
+
+	rt := &model.RefreshToken{
+		UserID:    userID,
+		TokenHash: hashToken(raw),
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(s.RefreshTokenTTL),
+	}
+	if err := db.Create(rt).Error; err != nil {
+		return "", nil, err
+	}
+	return raw, rt, nil
+}
+
+// issueTokens membuat pasangan access + refresh token untuk user.
+func (s *AuthServer) issueTokens(db *gorm.DB, user *model.User, familyID string) (*pb.LoginResponse, *model.RefreshToken, error) {
+	access, err := s.signAccessToken(user)
+	if err != nil {
+		return nil, nil, err
+	}
+	refresh, rt, err := s.createRefreshToken(db, user.ID, familyID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &pb.LoginResponse{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(s.AccessTokenTTL.Seconds()),
+	}, rt, nil
+}
+
+// revokeFamily mencabut semua refresh token yang masih aktif dalam satu family.
+func revokeFamily(db *gorm.DB, familyID string) error {
+	return db.Model(&model.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+}
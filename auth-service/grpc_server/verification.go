@@ -0,0 +1,126 @@
+package grpc_server
+
+import (
+	"auth-service/kafka"
+	"auth-service/model"
+	pb "auth-service/proto/auth"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const verificationResentMessage = "if the account exists and is not verified, a verification email has been sent"
+
+// sendVerification membuat token verifikasi baru (token lama tidak berlaku) dan mengirim event ke mailer.
+func (s *AuthServer) sendVerification(ctx context.Context, user *model.User) error {
+	raw, err := newOpaqueToken()
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(s.EmailVerificationTTL)
+
+	err = s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.EmailVerificationToken{}).
+			Where("user_id = ? AND used_at IS NULL", user.ID).
+			Update("used_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.EmailVerificationToken{
+			UserID:    user.ID,
+			TokenHash: hashToken(raw),
+			ExpiresAt: expiresAt,
+		}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	go kafka.PublishVerificationRequestedEvent(map[string]interface{}{
+		"user_id":    user.ID,
+		"email":      user.Email,
+		"name":       user.Name,
+		"token":      raw,
+		"expires_at": expiresAt,
+	})
+	return nil
+}
+
+// VerifyEmail menandai email user sebagai terverifikasi memakai token sekali pakai.
+func (s *AuthServer) VerifyEmail(ctx context.Context, in *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	if in.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	var user model.User
+	err := s.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var vt model.EmailVerificationToken
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ?", hashToken(in.Token)).
+			First(&vt).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.InvalidArgument, "invalid or expired verification token")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "query error: %v", err)
+		}
+		if vt.UsedAt != nil || time.Now().After(vt.ExpiresAt) {
+			return status.Errorf(codes.InvalidArgument, "invalid or expired verification token")
+		}
+
+		if err := tx.First(&user, vt.UserID).Error; err != nil {
+			return status.Errorf(codes.NotFound, "user not found")
+		}
+		wasVerified := user.EmailVerified
+
+		now := time.Now()
+		if err := tx.Model(&user).Updates(map[string]interface{}{
+			"email_verified":    true,
+			"email_verified_at": now,
+		}).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to verify email: %v", err)
+		}
+		if err := tx.Model(&vt).Update("used_at", now).Error; err != nil {
+			return err
+		}
+
+		// user.created ditahan sampai verifikasi kalau policy-nya aktif
+		if s.RequireEmailVerification && !wasVerified {
+			return enqueueUserCreated(tx, &user)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.VerifyEmailResponse{Message: "email verified"}, nil
+}
+
+// ResendVerification mengirim ulang email verifikasi. Response selalu sama supaya tidak bisa dipakai enumerasi akun.
+func (s *AuthServer) ResendVerification(ctx context.Context, in *pb.ResendVerificationRequest) (*pb.VerifyEmailResponse, error) {
+	email := strings.TrimSpace(in.Email)
+	if email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+
+	var user model.User
+	err := s.DB.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && user.EmailVerified) {
+		return &pb.VerifyEmailResponse{Message: verificationResentMessage}, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	if err := s.sendVerification(ctx, &user); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to send verification: %v", err)
+	}
+
+	return &pb.VerifyEmailResponse{Message: verificationResentMessage}, nil
+}
@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"os"
 	"time"
@@ -37,31 +38,85 @@ func InitProducer() {
 	log.Fatalf("❌ Could not connect to Kafka after 5 attempts: %v", err)
 }
 
-func PublishUserCreatedEvent(user interface{}) {
+// Send mengirim pesan yang sudah di-encode dan mengembalikan error-nya ke pemanggil,
+// dipakai outbox relay yang perlu tahu apakah pesan benar-benar terkirim.
+// key menentukan partisi, sehingga event untuk user yang sama tetap berurutan.
+func Send(topic, key string, value []byte) error {
 	if Producer == nil {
-		log.Println(" Kafka producer is nil — event not sent")
+		return fmt.Errorf("kafka producer is nil")
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(value),
+	}
+	if key != "" {
+		msg.Key = sarama.StringEncoder(key)
+	}
+
+	_, _, err := Producer.SendMessage(msg)
+	return err
+}
+
+// publish mengirim event dengan format {"event_type": ..., "data": ...} ke topic.
+func publish(topic, eventType string, data interface{}) {
+	if Producer == nil {
+		log.Printf(" Kafka producer is nil — %s event not sent", eventType)
 		return
 	}
 
 	event := map[string]interface{}{
-		"event_type": "user_created",
-		"data":       user,
+		"event_type": eventType,
+		"data":       data,
 	}
 
 	messageBytes, err := json.Marshal(event)
 	if err != nil {
-		log.Printf(" Failed to marshal user event: %v", err)
+		log.Printf(" Failed to marshal %s event: %v", eventType, err)
 		return
 	}
 
 	msg := &sarama.ProducerMessage{
-		Topic: "user.created",
+		Topic: topic,
 		Value: sarama.ByteEncoder(messageBytes),
 	}
 
 	if _, _, err := Producer.SendMessage(msg); err != nil {
-		log.Printf(" Failed to send Kafka message: %v", err)
-	} else {
-		log.Println("User created event sent to Kafka")
+		log.Printf(" Failed to send Kafka message (%s): %v", topic, err)
 	}
 }
+
+// PublishPasswordResetRequestedEvent dikonsumsi mailer untuk mengirim link reset password.
+func PublishPasswordResetRequestedEvent(data interface{}) {
+	publish("auth.password_reset_requested", "password_reset_requested", data)
+}
+
+// PublishVerificationRequestedEvent dikonsumsi mailer untuk mengirim link verifikasi email.
+func PublishVerificationRequestedEvent(data interface{}) {
+	publish("auth.verification_requested", "verification_requested", data)
+}
+
+// PublishUserUpdatedEvent mengirim data user terbaru ke user-service dan search-service.
+func PublishUserUpdatedEvent(user interface{}) {
+	publish("user.updated", "user_updated", user)
+}
+
+// PublishLoginFailedEvent dipakai untuk audit percobaan login yang gagal.
+func PublishLoginFailedEvent(data interface{}) {
+	publish("auth.login_failed", "login_failed", data)
+}
+
+// PublishAccountLockedEvent dikirim saat akun dikunci sementara karena terlalu banyak login gagal.
+func PublishAccountLockedEvent(data interface{}) {
+	publish("auth.account_locked", "account_locked", data)
+}
+
+// PublishErasureCompletedEvent melaporkan hasil penghapusan data user ke user-service.
+func PublishErasureCompletedEvent(data interface{}) {
+	publish("user.erasure_completed", "user_erasure_completed", data)
+}
+
+// PublishImpersonationStartedEvent dipakai untuk audit token impersonation yang diterbitkan.
+func PublishImpersonationStartedEvent(data interface{}) {
+	publish("auth.impersonation_started", "impersonation_started", data)
+}
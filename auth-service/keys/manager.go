@@ -0,0 +1,223 @@
+package keys
+
+import (
+	"auth-service/model"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+const algorithm = "RS256"
+
+type signingKey struct {
+	kid     string
+	private *rsa.PrivateKey
+}
+
+// Manager menyimpan key RSA untuk tanda tangan JWT di authdb.
+// Key aktif dirotasi tiap RotationInterval; key lama tetap dipublikasikan
+// di JWKS selama RetentionPeriod supaya token yang sudah terbit masih bisa diverifikasi.
+type Manager struct {
+	DB               *gorm.DB
+	RotationInterval time.Duration
+	RetentionPeriod  time.Duration
+
+	mu     sync.RWMutex
+	active *signingKey
+	public map[string]*rsa.PublicKey
+}
+
+func NewManager(db *gorm.DB, rotation, retention time.Duration) (*Manager, error) {
+	m := &Manager{
+		DB:               db,
+		RotationInterval: rotation,
+		RetentionPeriod:  retention,
+	}
+	if err := m.Load(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Load membaca ulang key dari DB dan membuat key baru kalau key aktif sudah waktunya dirotasi.
+func (m *Manager) Load() error {
+	var rows []model.SigningKey
+	err := m.DB.Where("retired_at IS NULL OR retired_at > ?", time.Now().Add(-m.RetentionPeriod)).
+		Order("created_at DESC").
+		Find(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	var active *signingKey
+	public := make(map[string]*rsa.PublicKey, len(rows))
+	for _, row := range rows {
+		priv, err := parsePrivateKey(row.PrivateKey)
+		if err != nil {
+			log.Printf("skip signing key %s: %v", row.Kid, err)
+			continue
+		}
+		public[row.Kid] = &priv.PublicKey
+		if active == nil && row.RetiredAt == nil && time.Since(row.CreatedAt) < m.RotationInterval {
+			active = &signingKey{kid: row.Kid, private: priv}
+		}
+	}
+
+	m.mu.Lock()
+	m.active = active
+	m.public = public
+	m.mu.Unlock()
+
+	if active == nil {
+		return m.Rotate()
+	}
+	return nil
+}
+
+// Rotate membuat key baru dan memensiunkan key yang sedang aktif.
+func (m *Manager) Rotate() error {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+	kid, err := newKid()
+	if err != nil {
+		return err
+	}
+
+	pemBytes := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(priv),
+	})
+
+	err = m.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.SigningKey{}).
+			Where("retired_at IS NULL").
+			Update("retired_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.SigningKey{
+			Kid:        kid,
+			Algorithm:  algorithm,
+			PrivateKey: string(pemBytes),
+		}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.active = &signingKey{kid: kid, private: priv}
+	m.public[kid] = &priv.PublicKey
+	m.mu.Unlock()
+
+	log.Printf("JWT signing key rotated, kid=%s", kid)
+	return nil
+}
+
+// Start menjalankan pengecekan rotasi secara berkala (juga menyinkronkan key antar instance).
+func (m *Manager) Start(ctx context.Context, every time.Duration) {
+	go func() {
+		ticker := time.NewTicker(every)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := m.Load(); err != nil {
+					log.Printf("failed to reload signing keys: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Sign menandatangani claims dengan key aktif (header kid ikut diisi).
+func (m *Manager) Sign(claims jwt.Claims) (string, error) {
+	m.mu.RLock()
+	active := m.active
+	m.mu.RUnlock()
+	if active == nil {
+		return "", fmt.Errorf("no active signing key")
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = active.kid
+	return token.SignedString(active.private)
+}
+
+// Keyfunc dipakai jwt.Parse untuk memilih public key berdasarkan kid.
+func (m *Manager) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	key, ok := m.public[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+	return key, nil
+}
+
+// ====================== JWKS ======================
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+func (m *Manager) JWKS() JWKS {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	set := JWKS{Keys: make([]JWK, 0, len(m.public))}
+	for kid, pub := range m.public {
+		set.Keys = append(set.Keys, JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: algorithm,
+			Kid: kid,
+			N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		})
+	}
+	return set
+}
+
+// ====================== HELPER ======================
+
+func parsePrivateKey(pemStr string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(pemStr))
+	if block == nil {
+		return nil, fmt.Errorf("invalid pem")
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
+
+func newKid() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
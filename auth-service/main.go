@@ -3,16 +3,26 @@ package main
 import (
 	"auth-service/grpc_server"
 	"auth-service/kafka"
+	"auth-service/keys"
+	"auth-service/middleware"
 
 	"auth-service/model"
+	"auth-service/oauth"
+	"auth-service/outbox"
 	pb "auth-service/proto/auth"
 	"auth-service/routes"
+	"auth-service/svcauth"
+	"context"
 	"log"
 	"net"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -38,10 +48,23 @@ func initDB() {
 		log.Fatal("failed to connect database:", err)
 	}
 
-	if err := DB.AutoMigrate(&model.User{}); err != nil {
+	// akun yang sudah ada sebelum verifikasi email diperkenalkan dianggap terverifikasi
+	backfillVerified := !DB.Migrator().HasColumn(&model.User{}, "email_verified")
+
+	if err := DB.AutoMigrate(&model.User{}, &model.RefreshToken{}, &model.SigningKey{}, &model.PasswordResetToken{}, &model.EmailVerificationToken{}, &model.RoleAuditLog{}, &model.RecoveryCode{}, &model.UserIdentity{}, &model.APIKey{}, &model.Permission{}, &model.Role{}, &model.OutboxEvent{}, &model.AccountAuditLog{}); err != nil {
 		log.Fatal("failed to migrate:", err)
 	}
 
+	if backfillVerified {
+		if err := DB.Model(&model.User{}).Where("1 = 1").Update("email_verified", true).Error; err != nil {
+			log.Fatal("failed to backfill email_verified:", err)
+		}
+	}
+
+	if err := grpc_server.SeedRoles(DB); err != nil {
+		log.Fatal("failed to seed roles:", err)
+	}
+
 	log.Println("Connected to Auth DB:", name)
 }
 
@@ -49,13 +72,87 @@ func initDB() {
 // Main Function
 // --------------------
 func main() {
+	svcauth.Init()
 	initDB()
 	kafka.InitProducer()
-	jwtSecret := getEnv("JWT_SECRET", "verysecretkey")
+	accessTTL := getDurationEnv("ACCESS_TOKEN_TTL", 15*time.Minute)
+	refreshTTL := getDurationEnv("REFRESH_TOKEN_TTL", 30*24*time.Hour)
+	resetTTL := getDurationEnv("PASSWORD_RESET_TTL", 30*time.Minute)
+	verificationTTL := getDurationEnv("EMAIL_VERIFICATION_TTL", 24*time.Hour)
+	requireVerification := getEnv("REQUIRE_EMAIL_VERIFICATION", "false") == "true"
+	mfaRoles := map[string]bool{}
+	for _, r := range strings.Split(getEnv("REQUIRE_2FA_ROLES", "admin"), ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			mfaRoles[r] = true
+		}
+	}
+	issuer := getEnv("JWT_ISSUER", "auth-service")
+
+	// key lama harus tetap dipublikasikan minimal selama umur access token
+	rotation := getDurationEnv("JWT_KEY_ROTATION", 30*24*time.Hour)
+	retention := getDurationEnv("JWT_KEY_RETENTION", 24*time.Hour)
+	if retention < accessTTL {
+		retention = accessTTL
+	}
+	km, err := keys.NewManager(DB, rotation, retention)
+	if err != nil {
+		log.Fatal("failed to load signing keys:", err)
+	}
+	km.Start(context.Background(), time.Minute)
+
+	// event di outbox (mis. user.created) dikirim ke Kafka oleh relay
+	relay := &outbox.Relay{
+		DB:        DB,
+		Interval:  getDurationEnv("OUTBOX_RELAY_INTERVAL", time.Second),
+		BatchSize: getIntEnv("OUTBOX_RELAY_BATCH", 100),
+		Retention: getDurationEnv("OUTBOX_RETENTION", 7*24*time.Hour),
+	}
+	relay.Start(context.Background())
+
+	rdb := redis.NewClient(&redis.Options{
+		Addr: getEnv("REDIS_ADDR", "redis:6379"),
+	})
+
+	authServer := &grpc_server.AuthServer{
+		DB:                       DB,
+		Keys:                     km,
+		Issuer:                   issuer,
+		AccessTokenTTL:           accessTTL,
+		RefreshTokenTTL:          refreshTTL,
+		PasswordResetTTL:         resetTTL,
+		RequireEmailVerification: requireVerification,
+		EmailVerificationTTL:     verificationTTL,
+		MFARequiredRoles:         mfaRoles,
+		MFAChallengeTTL:          getDurationEnv("MFA_CHALLENGE_TTL", 5*time.Minute),
+		TOTPIssuer:               getEnv("TOTP_ISSUER", "goecommerce"),
+		OAuth:                    oauth.LoadFromEnv(),
+		OAuthStateTTL:            getDurationEnv("OAUTH_STATE_TTL", 10*time.Minute),
+		ImpersonationTTL:         getDurationEnv("IMPERSONATION_TTL", 10*time.Minute),
+		LoginPolicy: grpc_server.LoginPolicy{
+			MaxFailuresPerEmail: int64(getIntEnv("LOGIN_MAX_FAILURES_PER_EMAIL", 5)),
+			MaxFailuresPerIP:    int64(getIntEnv("LOGIN_MAX_FAILURES_PER_IP", 20)),
+			FailureWindow:       getDurationEnv("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+			LockoutDuration:     getDurationEnv("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+			BaseDelay:           getDurationEnv("LOGIN_BASE_DELAY", time.Second),
+			MaxDelay:            getDurationEnv("LOGIN_MAX_DELAY", 30*time.Second),
+		},
+		Redis: rdb,
+	}
+
+	if err := authServer.SyncSuspensions(context.Background()); err != nil {
+		log.Println("failed to sync suspended users to redis:", err)
+	}
 
 	// Jalankan HTTP (Fiber)
 	go func() {
-		app := fiber.New()
+		// IP client untuk lockout login diambil dari X-Forwarded-For yang ditulis nginx;
+		// header dari koneksi selain TRUSTED_PROXIES diabaikan supaya tidak bisa dipalsukan
+		app := fiber.New(fiber.Config{
+			ProxyHeader:             fiber.HeaderXForwardedFor,
+			EnableTrustedProxyCheck: true,
+			TrustedProxies:          splitList(getEnv("TRUSTED_PROXIES", "")),
+			EnableIPValidation:      true,
+		})
 		app.Use(logger.New())
 
 		// Tambahkan route utama
@@ -67,7 +164,8 @@ func main() {
 		})
 
 		// ✅ Register semua endpoint auth
-		routes.RegisterAuthRoutes(app)
+		routes.RegisterAuthRoutes(app, middleware.AuthMiddleware())
+		routes.RegisterJWKSRoutes(app, km)
 
 		log.Println("HTTP server running on :3002")
 		if err := app.Listen(":3002"); err != nil {
@@ -82,11 +180,8 @@ func main() {
 			log.Fatal("failed to listen:", err)
 		}
 
-		grpcServer := grpc.NewServer()
-		pb.RegisterAuthServiceServer(grpcServer, &grpc_server.AuthServer{
-			DB:        DB,
-			JWTSecret: jwtSecret,
-		})
+		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(svcauth.UnaryInterceptor(svcauth.ServerPolicy)))
+		pb.RegisterAuthServiceServer(grpcServer, authServer)
 
 		log.Println("gRPC server running on :50052")
 		if err := grpcServer.Serve(listener); err != nil {
@@ -94,6 +189,11 @@ func main() {
 		}
 	}()
 
+	// penghapusan akun dari user-service
+	consumer := kafka.NewConsumer()
+	consumer.Consume("user.deleted", authServer.HandleUserDeleted)
+	consumer.Start(context.Background())
+
 	select {}
 }
 
@@ -106,3 +206,49 @@ func getEnv(key, def string) string {
 	}
 	return def
 }
+
+// --------------------
+// Helper: getDurationEnv
+// --------------------
+func getDurationEnv(key string, def time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return def
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		log.Printf("invalid %s=%q, using default %s", key, val, def)
+		return def
+	}
+	return d
+}
+
+// --------------------
+// Helper: getIntEnv
+// --------------------
+func getIntEnv(key string, def int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return def
+	}
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		log.Printf("invalid %s=%q, using default %d", key, val, def)
+		return def
+	}
+	return n
+}
+
+// --------------------
+// Helper: splitList
+// --------------------
+// splitList memecah daftar dipisah koma (mis. TRUSTED_PROXIES), entri kosong dibuang.
+func splitList(val string) []string {
+	var out []string
+	for _, v := range strings.Split(val, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	authpb "auth-service/proto/auth"
+	"auth-service/svcauth"
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc"
+)
+
+func AuthMiddleware() fiber.Handler {
+	conn, err := grpc.Dial("localhost:50052", grpc.WithInsecure(), svcauth.DialOption())
+	if err != nil {
+		panic("failed to connect to auth-service gRPC: " + err.Error())
+	}
+	client := authpb.NewAuthServiceClient(conn)
+
+	return func(c *fiber.Ctx) error {
+		req := &authpb.ValidateTokenRequest{ApiKey: c.Get("X-API-Key")}
+		if req.ApiKey == "" {
+			authHeader := c.Get("Authorization")
+			if authHeader == "" {
+				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "missing token"})
+			}
+			req.Token = strings.TrimPrefix(authHeader, "Bearer ")
+		}
+
+		res, err := client.ValidateToken(context.Background(), req)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid token"})
+		}
+
+		c.Locals("user_id", res.Id)
+		c.Locals("email", res.Email)
+		c.Locals("role", res.Role)
+		c.Locals("permissions", res.Permissions)
+		if res.ApiKeyId != 0 {
+			c.Locals("api_key_id", res.ApiKeyId)
+		}
+		if res.ImpersonatorId != 0 {
+			// token impersonation hanya untuk melihat, bukan mengubah data user
+			if !isReadOnlyMethod(c.Method()) {
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "impersonation token is read-only"})
+			}
+			c.Locals("impersonator_id", res.ImpersonatorId)
+		}
+
+		return c.Next()
+	}
+}
+
+func isReadOnlyMethod(method string) bool {
+	return method == fiber.MethodGet || method == fiber.MethodHead || method == fiber.MethodOptions
+}
+
+// RequirePermission mengizinkan request hanya kalau token membawa permission tersebut.
+func RequirePermission(permission string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		perms, _ := c.Locals("permissions").([]string)
+		for _, p := range perms {
+			if p == permission {
+				return c.Next()
+			}
+		}
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden: missing permission " + permission})
+	}
+}
@@ -1,9 +1,136 @@
 package model
 
+import "time"
+
 type User struct {
-	ID       uint   `gorm:"primaryKey" json:"id"`
-	Email    string `gorm:"uniqueIndex" json:"email"`
-	Password string `json:"-"`
-	Name     string `json:"name"`
-	Role     string `json:"role"`
+	ID              uint       `gorm:"primaryKey" json:"id"`
+	Email           string     `gorm:"uniqueIndex" json:"email"`
+	Password        string     `json:"-"`
+	Name            string     `json:"name"`
+	Role            string     `json:"role"`
+	EmailVerified   bool       `gorm:"default:false" json:"email_verified"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// 2FA: TOTPSecret terisi sejak enroll, baru aktif setelah dikonfirmasi
+	TOTPSecret   string `json:"-"`
+	TOTPEnabled  bool   `gorm:"default:false" json:"totp_enabled"`
+	TOTPLastStep int64  `json:"-"` // step terakhir yang dipakai, mencegah replay kode yang sama
+	// akun yang di-suspend admin tidak bisa login dan token-nya ditolak
+	SuspendedAt     *time.Time `json:"suspended_at,omitempty"`
+	SuspendedReason string     `json:"suspended_reason,omitempty"`
+}
+
+// RefreshToken disimpan dalam bentuk hash, token aslinya hanya dikirim ke client.
+// Setiap refresh merotasi token; semua token hasil rotasi berbagi FamilyID
+// supaya pemakaian ulang token lama bisa mencabut seluruh rantai.
+type RefreshToken struct {
+	ID         uint       `gorm:"primaryKey" json:"id"`
+	UserID     uint       `gorm:"index" json:"user_id"`
+	TokenHash  string     `gorm:"uniqueIndex" json:"-"`
+	FamilyID   string     `gorm:"index" json:"family_id"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	ReplacedBy *uint      `json:"replaced_by,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// SigningKey adalah key RSA untuk tanda tangan JWT. Key yang sudah pensiun
+// (RetiredAt terisi) masih dipublikasikan di JWKS sampai masa retensinya habis.
+type SigningKey struct {
+	ID         uint       `gorm:"primaryKey" json:"id"`
+	Kid        string     `gorm:"uniqueIndex" json:"kid"`
+	Algorithm  string     `json:"algorithm"`
+	PrivateKey string     `json:"-"`
+	CreatedAt  time.Time  `json:"created_at"`
+	RetiredAt  *time.Time `json:"retired_at,omitempty"`
+}
+
+// PasswordResetToken hanya berlaku sekali pakai; yang disimpan hanya hash-nya.
+type PasswordResetToken struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"index" json:"user_id"`
+	TokenHash string     `gorm:"uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// EmailVerificationToken dikirim ke email user saat registrasi; yang disimpan hanya hash-nya.
+type EmailVerificationToken struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"index" json:"user_id"`
+	TokenHash string     `gorm:"uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// RoleAuditLog mencatat setiap perubahan role oleh admin.
+type RoleAuditLog struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"index" json:"user_id"`
+	ActorID   uint      `gorm:"index" json:"actor_id"`
+	Action    string    `json:"action"` // "assign" or "revoke"
+	OldRole   string    `json:"old_role"`
+	NewRole   string    `json:"new_role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AccountAuditLog mencatat tindakan admin / support terhadap akun user.
+type AccountAuditLog struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"index" json:"user_id"`
+	ActorID   uint      `gorm:"index" json:"actor_id"`
+	Action    string    `json:"action"` // "suspend", "reactivate" or "impersonate"
+	Reason    string    `json:"reason"`
+	TokenJTI  string    `json:"token_jti,omitempty"` // jti token impersonation
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RecoveryCode dipakai sekali kalau authenticator hilang; yang disimpan hanya hash-nya.
+type RecoveryCode struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"index" json:"user_id"`
+	CodeHash  string     `gorm:"index" json:"-"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// UserIdentity menghubungkan user lokal dengan akun di identity provider eksternal (OIDC).
+type UserIdentity struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"index" json:"user_id"`
+	Provider  string    `gorm:"uniqueIndex:idx_identity_provider_subject" json:"provider"`
+	Subject   string    `gorm:"uniqueIndex:idx_identity_provider_subject" json:"subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// APIKey dipakai client mesin (integrasi gudang / ERP) sebagai ganti login user.
+// Key disimpan dalam bentuk hash; Prefix hanya untuk identifikasi di daftar key.
+type APIKey struct {
+	ID         uint       `gorm:"primaryKey" json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `gorm:"index" json:"prefix"`
+	KeyHash    string     `gorm:"uniqueIndex" json:"-"`
+	UserID     uint       `gorm:"index" json:"user_id"`
+	Scopes     []string   `gorm:"serializer:json" json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedBy  uint       `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// OutboxEvent adalah event Kafka yang ditulis dalam transaksi yang sama dengan
+// perubahan datanya; outbox relay yang mengirimkannya ke Kafka.
+type OutboxEvent struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	Topic       string     `json:"topic"`
+	EventType   string     `json:"event_type"`
+	Key         string     `json:"key"`
+	Payload     string     `gorm:"type:jsonb" json:"payload"`
+	Attempts    int        `gorm:"default:0" json:"attempts"`
+	LastError   string     `json:"last_error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	PublishedAt *time.Time `gorm:"index" json:"published_at,omitempty"`
 }
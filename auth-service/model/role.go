@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// Permission adalah hak akses bernama, mis. "product:write" atau "payment:read_all".
+type Permission struct {
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	Name        string `gorm:"uniqueIndex" json:"name"`
+	Description string `json:"description"`
+}
+
+// Role adalah kumpulan permission. User.Role menyimpan nama role.
+type Role struct {
+	ID          uint         `gorm:"primaryKey" json:"id"`
+	Name        string       `gorm:"uniqueIndex" json:"name"`
+	Description string       `json:"description"`
+	Permissions []Permission `gorm:"many2many:role_permissions" json:"permissions"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
@@ -0,0 +1,138 @@
+package oauth_test
+
+import (
+	"auth-service/oauth"
+	"auth-service/oauth/oauthtest"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const clientID = "test-client"
+
+func newProvider(t *testing.T) (*oauthtest.Issuer, *oauth.OIDCProvider) {
+	t.Helper()
+	iss, err := oauthtest.NewIssuer(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(iss.Close)
+
+	p := oauth.NewOIDCProvider(oauth.OIDCConfig{
+		Name:        "test",
+		IssuerURL:   iss.URL,
+		ClientID:    clientID,
+		RedirectURL: "http://localhost/callback",
+	})
+	return iss, p
+}
+
+// authorize menjalankan AuthCodeURL + login di issuer dan mengembalikan code.
+func authorize(t *testing.T, iss *oauthtest.Issuer, p *oauth.OIDCProvider, verifier, nonce string, claims jwt.MapClaims) string {
+	t.Helper()
+	u, err := p.AuthCodeURL("state", oauth.CodeChallenge(verifier), nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := iss.Authorize(u, claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func TestCodeChallengeRFC7636(t *testing.T) {
+	// contoh dari RFC 7636 appendix B
+	got := oauth.CodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+	if want := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"; got != want {
+		t.Fatalf("CodeChallenge = %q, want %q", got, want)
+	}
+}
+
+func TestExchangePKCERoundTrip(t *testing.T) {
+	iss, p := newProvider(t)
+	verifier, _ := oauth.RandomString()
+
+	code := authorize(t, iss, p, verifier, "n-1", jwt.MapClaims{
+		"sub": "subject-1", "email": "a@example.com", "email_verified": true, "name": "A",
+	})
+	id, err := p.Exchange(context.Background(), code, verifier, "n-1")
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if id.Subject != "subject-1" || id.Email != "a@example.com" || !id.EmailVerified || id.Name != "A" {
+		t.Fatalf("unexpected identity %+v", id)
+	}
+
+	// code sekali pakai
+	if _, err := p.Exchange(context.Background(), code, verifier, "n-1"); err == nil {
+		t.Fatal("expected reused code to be rejected")
+	}
+}
+
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	iss, p := newProvider(t)
+	verifier, _ := oauth.RandomString()
+	other, _ := oauth.RandomString()
+
+	code := authorize(t, iss, p, verifier, "n", jwt.MapClaims{"sub": "s"})
+	if _, err := p.Exchange(context.Background(), code, other, "n"); err == nil {
+		t.Fatal("expected exchange with another code_verifier to fail")
+	}
+}
+
+func TestExchangeRejectsInvalidIDToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		nonce  string
+		errMsg string
+	}{
+		{"nonce mismatch", jwt.MapClaims{"sub": "s"}, "other-nonce", "nonce mismatch"},
+		{"audience mismatch", jwt.MapClaims{"sub": "s", "aud": "another-client"}, "n", "audience"},
+		{"issuer mismatch", jwt.MapClaims{"sub": "s", "iss": "https://evil.example.com"}, "n", "issuer"},
+		{"expired", jwt.MapClaims{"sub": "s", "exp": time.Now().Add(-time.Minute).Unix()}, "n", "expired"},
+		{"no subject", jwt.MapClaims{"email": "a@example.com"}, "n", "no subject"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss, p := newProvider(t)
+			verifier, _ := oauth.RandomString()
+
+			code := authorize(t, iss, p, verifier, "n", tt.claims)
+			_, err := p.Exchange(context.Background(), code, verifier, tt.nonce)
+			if err == nil {
+				t.Fatal("expected id_token to be rejected")
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Fatalf("error %q does not mention %q", err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestExchangeEmailVerifiedAsString(t *testing.T) {
+	for _, tt := range []struct {
+		value interface{}
+		want  bool
+	}{{"true", true}, {"false", false}, {false, false}, {nil, false}} {
+		iss, p := newProvider(t)
+		verifier, _ := oauth.RandomString()
+		claims := jwt.MapClaims{"sub": "s", "email": "a@example.com"}
+		if tt.value != nil {
+			claims["email_verified"] = tt.value
+		}
+
+		code := authorize(t, iss, p, verifier, "n", claims)
+		id, err := p.Exchange(context.Background(), code, verifier, "n")
+		if err != nil {
+			t.Fatalf("email_verified=%v: %v", tt.value, err)
+		}
+		if id.EmailVerified != tt.want {
+			t.Fatalf("email_verified=%v: got %v, want %v", tt.value, id.EmailVerified, tt.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// Identity adalah data user yang dikembalikan identity provider setelah login.
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Provider adalah identity provider eksternal dengan authorization-code flow + PKCE.
+type Provider interface {
+	Name() string
+	// AuthCodeURL membuat URL login provider. codeChallenge adalah S256 dari code verifier.
+	AuthCodeURL(state, codeChallenge, nonce string) (string, error)
+	// Exchange menukar authorization code dengan identitas user yang sudah diverifikasi.
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error)
+}
+
+// Registry menyimpan provider yang aktif berdasarkan nama.
+type Registry struct {
+	providers map[string]Provider
+}
+
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{providers: map[string]Provider{}}
+	for _, p := range providers {
+		r.providers[p.Name()] = p
+	}
+	return r
+}
+
+func (r *Registry) Get(name string) (Provider, bool) {
+	p, ok := r.providers[name]
+	return p, ok
+}
+
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	return names
+}
+
+// issuer bawaan untuk provider yang umum, provider lain wajib mengisi OAUTH_<NAME>_ISSUER
+var knownIssuers = map[string]string{
+	"google":    "https://accounts.google.com",
+	"microsoft": "https://login.microsoftonline.com/common/v2.0",
+}
+
+// LoadFromEnv membaca OAUTH_PROVIDERS (mis. "google,keycloak") lalu konfigurasi per provider:
+// OAUTH_<NAME>_ISSUER, OAUTH_<NAME>_CLIENT_ID, OAUTH_<NAME>_CLIENT_SECRET, OAUTH_<NAME>_REDIRECT_URL.
+func LoadFromEnv() *Registry {
+	var providers []Provider
+	for _, name := range strings.Split(os.Getenv("OAUTH_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OAUTH_" + strings.ToUpper(name) + "_"
+		issuer := os.Getenv(prefix + "ISSUER")
+		if issuer == "" {
+			issuer = knownIssuers[name]
+		}
+		cfg := OIDCConfig{
+			Name:         name,
+			IssuerURL:    issuer,
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+		}
+		if cfg.IssuerURL == "" || cfg.ClientID == "" || cfg.RedirectURL == "" {
+			log.Printf("oauth provider %s is missing issuer / client id / redirect url, skipped", name)
+			continue
+		}
+		providers = append(providers, NewOIDCProvider(cfg))
+	}
+	return NewRegistry(providers...)
+}
+
+// ====================== PKCE ======================
+
+func RandomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random string: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge menghitung S256 code challenge dari code verifier (RFC 7636).
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
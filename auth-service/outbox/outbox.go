@@ -0,0 +1,117 @@
+package outbox
+
+import (
+	"auth-service/kafka"
+	"auth-service/model"
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Enqueue menulis event ke tabel outbox memakai tx yang sama dengan perubahan datanya,
+// jadi event hanya ada kalau transaksi commit dan tidak hilang kalau Kafka sedang mati.
+// Relay yang mengirimkannya ke Kafka.
+func Enqueue(tx *gorm.DB, topic, eventType, key string, data interface{}) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"event_type": eventType,
+		"data":       data,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&model.OutboxEvent{
+		Topic:     topic,
+		EventType: eventType,
+		Key:       key,
+		Payload:   string(payload),
+	}).Error
+}
+
+// Relay memindahkan event outbox yang belum terkirim ke Kafka, berurutan sesuai ID.
+// Pengiriman at-least-once: kalau proses mati setelah kirim tapi sebelum menandai
+// published, event akan terkirim ulang, jadi consumer harus idempotent.
+type Relay struct {
+	DB        *gorm.DB
+	Interval  time.Duration
+	BatchSize int
+	// event yang sudah terkirim dihapus setelah Retention
+	Retention time.Duration
+}
+
+func (r *Relay) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.Interval)
+		defer ticker.Stop()
+		lastCleanup := time.Time{}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// kirim terus selama batch penuh supaya backlog cepat habis
+				for {
+					n, err := r.flush(ctx)
+					if err != nil {
+						log.Printf("outbox relay: %v", err)
+						break
+					}
+					if n < r.BatchSize {
+						break
+					}
+				}
+
+				if time.Since(lastCleanup) > time.Hour {
+					r.cleanup(ctx)
+					lastCleanup = time.Now()
+				}
+			}
+		}
+	}()
+}
+
+// flush mengirim satu batch. SKIP LOCKED membuat beberapa instance auth-service
+// bisa menjalankan relay bersamaan tanpa mengirim event yang sama dua kali.
+// Kalau satu event gagal, batch berhenti di situ supaya urutan tetap terjaga.
+func (r *Relay) flush(ctx context.Context) (int, error) {
+	sent := 0
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var events []model.OutboxEvent
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL").
+			Order("id").
+			Limit(r.BatchSize).
+			Find(&events).Error; err != nil {
+			return err
+		}
+
+		for _, ev := range events {
+			if err := kafka.Send(ev.Topic, ev.Key, []byte(ev.Payload)); err != nil {
+				tx.Model(&ev).Updates(map[string]interface{}{
+					"attempts":   gorm.Expr("attempts + 1"),
+					"last_error": err.Error(),
+				})
+				return nil
+			}
+			if err := tx.Model(&ev).Update("published_at", time.Now()).Error; err != nil {
+				return err
+			}
+			sent++
+		}
+		return nil
+	})
+	return sent, err
+}
+
+func (r *Relay) cleanup(ctx context.Context) {
+	res := r.DB.WithContext(ctx).
+		Where("published_at < ?", time.Now().Add(-r.Retention)).
+		Delete(&model.OutboxEvent{})
+	if res.Error != nil {
+		log.Printf("outbox cleanup: %v", res.Error)
+	}
+}
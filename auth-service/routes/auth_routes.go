@@ -2,11 +2,12 @@ package routes
 
 import (
 	"auth-service/controller"
+	"auth-service/middleware"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func RegisterAuthRoutes(app *fiber.App) {
+func RegisterAuthRoutes(app *fiber.App, authMiddleware fiber.Handler) {
 	ac := controller.NewAuthController()
 
 	api := app.Group("/api")
@@ -15,5 +16,34 @@ func RegisterAuthRoutes(app *fiber.App) {
 	// Semua endpoint autentikasi ada di sini
 	a.Post("/register", ac.Register)
 	a.Post("/login", ac.Login)
+	a.Post("/refresh", ac.Refresh)
+	a.Post("/password/reset-request", ac.RequestPasswordReset)
+	a.Post("/password/reset", ac.ConfirmPasswordReset)
+	a.Post("/email/verify", ac.VerifyEmail)
+	a.Post("/email/resend", ac.ResendVerification)
 	a.Post("/validate", ac.ValidateToken)
+	a.Post("/logout", authMiddleware, ac.Logout)
+
+	// login lewat identity provider eksternal (OIDC)
+	a.Get("/oauth/:provider/start", ac.OAuthStart)
+	a.Get("/oauth/:provider/callback", ac.OAuthCallback)
+
+	// 2FA (enroll / confirm memvalidasi token sendiri karena menerima challenge token)
+	a.Post("/2fa/enroll", ac.EnrollTOTP)
+	a.Post("/2fa/confirm", ac.ConfirmTOTP)
+	a.Post("/2fa/verify", ac.VerifyTOTP)
+
+	// admin
+	a.Post("/users/:id/revoke-sessions", authMiddleware, middleware.RequirePermission("session:revoke"), ac.RevokeAllSessions)
+	a.Post("/users/:id/roles", authMiddleware, middleware.RequirePermission("role:write"), ac.AssignRole)
+	a.Delete("/users/:id/roles/:role", authMiddleware, middleware.RequirePermission("role:write"), ac.RevokeRole)
+	a.Post("/users/:id/unlock", authMiddleware, middleware.RequirePermission("account:unlock"), ac.UnlockAccount)
+	a.Post("/users/:id/suspend", authMiddleware, middleware.RequirePermission("user:suspend"), ac.SuspendUser)
+	a.Post("/users/:id/reactivate", authMiddleware, middleware.RequirePermission("user:suspend"), ac.ReactivateUser)
+	a.Post("/users/:id/impersonate", authMiddleware, middleware.RequirePermission("user:impersonate"), ac.Impersonate)
+	a.Post("/api-keys", authMiddleware, middleware.RequirePermission("apikey:write"), ac.CreateAPIKey)
+	a.Get("/api-keys", authMiddleware, middleware.RequirePermission("apikey:write"), ac.ListAPIKeys)
+	a.Delete("/api-keys/:id", authMiddleware, middleware.RequirePermission("apikey:write"), ac.RevokeAPIKey)
+	a.Get("/roles", authMiddleware, middleware.RequirePermission("role:write"), ac.ListRoles)
+	a.Put("/roles/:name", authMiddleware, middleware.RequirePermission("role:write"), ac.UpsertRole)
 }
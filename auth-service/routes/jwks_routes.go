@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"auth-service/controller"
+	"auth-service/keys"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterJWKSRoutes(app *fiber.App, km *keys.Manager) {
+	jc := controller.NewJWKSController(km)
+
+	app.Get("/.well-known/jwks.json", jc.GetJWKS)
+}
@@ -0,0 +1,22 @@
+package svcauth
+
+// ServiceName dipakai sebagai identitas service ini di service token.
+const ServiceName = "auth-service"
+
+// ServerPolicy adalah allowlist caller untuk RPC yang di-serve service ini.
+var ServerPolicy = Policy{
+	Methods: map[string][]string{
+		// dipakai middleware semua service untuk memvalidasi X-API-Key
+		"/auth.AuthService/ValidateToken": {
+			ServiceName, "user-service", "address-service", "search-service", "product-service",
+			"cart-service", "transaction-service", "payment-service",
+		},
+		// rekonsiliasi user.created
+		"/auth.AuthService/ListUsers": {ServiceName, "user-service"},
+		// admin user management di user-service
+		"/auth.AuthService/SuspendUser":    {ServiceName, "user-service"},
+		"/auth.AuthService/ReactivateUser": {ServiceName, "user-service"},
+		"/auth.AuthService/Impersonate":    {ServiceName, "user-service"},
+	},
+	Default: []string{ServiceName},
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"cart-service/grpc_client"
 	pb "cart-service/proto/cart"
+	"cart-service/svcauth"
 	"context"
 	"strconv"
 	"time"
@@ -65,8 +66,9 @@ func (cc *CartController) Create(c *fiber.Ctx) error {
 
 	var body struct {
 		Products []struct {
-			Id  uint32 `json:"id"`
-			Qty uint32 `json:"qty"`
+			Id        uint32 `json:"id"`
+			Qty       uint32 `json:"qty"`
+			VariantId uint32 `json:"variant_id"`
 		} `json:"products"`
 	}
 
@@ -78,8 +80,9 @@ func (cc *CartController) Create(c *fiber.Ctx) error {
 	var items []*pb.CartProduct
 	for _, p := range body.Products {
 		items = append(items, &pb.CartProduct{
-			Id:  p.Id,
-			Qty: p.Qty,
+			Id:        p.Id,
+			Qty:       p.Qty,
+			VariantId: p.VariantId,
 		})
 	}
 
@@ -103,8 +106,9 @@ func (cc *CartController) Update(c *fiber.Ctx) error {
 
 	var body struct {
 		Products []struct {
-			Id  uint32 `json:"id"`
-			Qty uint32 `json:"qty"`
+			Id        uint32 `json:"id"`
+			Qty       uint32 `json:"qty"`
+			VariantId uint32 `json:"variant_id"`
 		} `json:"products"`
 		Status string `json:"status"`
 	}
@@ -117,8 +121,9 @@ func (cc *CartController) Update(c *fiber.Ctx) error {
 	var items []*pb.CartProduct
 	for _, p := range body.Products {
 		items = append(items, &pb.CartProduct{
-			Id:  p.Id,
-			Qty: p.Qty,
+			Id:        p.Id,
+			Qty:       p.Qty,
+			VariantId: p.VariantId,
 		})
 	}
 
@@ -171,7 +176,7 @@ func (cc *CartController) GetAll(c *fiber.Ctx) error {
 
 // ===================== INIT ======================
 func NewCartController() *CartController {
-	conn, err := grpc.Dial("localhost:50055", grpc.WithInsecure())
+	conn, err := grpc.Dial("localhost:50055", grpc.WithInsecure(), svcauth.DialOption())
 	if err != nil {
 		panic("failed to connect to cart gRPC: " + err.Error())
 	}
@@ -91,10 +91,10 @@ func (s *CartServer) CreateCart(ctx context.Context, req *pb.CreateCartRequest)
 		existing = []model.CartProduct{}
 	}
 
-	// Build a map for quick lookup: productID -> index in existing slice
-	idxMap := make(map[uint]int, len(existing))
+	// Build a map for quick lookup: (productID, variantID) -> index in existing slice
+	idxMap := make(map[model.CartLine]int, len(existing))
 	for i, p := range existing {
-		idxMap[p.ID] = i
+		idxMap[p.Line()] = i
 	}
 
 	// Merge: for each incoming product (proto), add qty if exists else append
@@ -102,14 +102,14 @@ func (s *CartServer) CreateCart(ctx context.Context, req *pb.CreateCartRequest)
 		if in == nil {
 			continue
 		}
-		pid := uint(in.Id)
-		if i, ok := idxMap[pid]; ok {
+		line := model.CartLine{ProductID: uint(in.Id), VariantID: uint(in.VariantId)}
+		if i, ok := idxMap[line]; ok {
 			// increment qty
 			existing[i].Qty += uint(in.Qty)
 		} else {
 			// append new product
-			existing = append(existing, model.CartProduct{ID: pid, Qty: uint(in.Qty)})
-			idxMap[pid] = len(existing) - 1
+			existing = append(existing, model.CartProduct{ID: line.ProductID, Qty: uint(in.Qty), VariantID: line.VariantID})
+			idxMap[line] = len(existing) - 1
 		}
 	}
 
@@ -117,8 +117,9 @@ func (s *CartServer) CreateCart(ctx context.Context, req *pb.CreateCartRequest)
 	pbProducts := make([]*pb.CartProduct, 0, len(existing))
 	for _, p := range existing {
 		pbProducts = append(pbProducts, &pb.CartProduct{
-			Id:  uint32(p.ID),
-			Qty: uint32(p.Qty),
+			Id:        uint32(p.ID),
+			Qty:       uint32(p.Qty),
+			VariantId: uint32(p.VariantID),
 		})
 	}
 	productsBytes, _ := json.Marshal(pbProducts)
@@ -187,8 +188,9 @@ func (s *CartServer) GetCart(ctx context.Context, req *pb.GetCartRequest) (*pb.C
 	var pbProducts []*pb.CartProduct
 	for _, p := range c.Products {
 		pbProducts = append(pbProducts, &pb.CartProduct{
-			Id:  uint32(p.ID),
-			Qty: uint32(p.Qty),
+			Id:        uint32(p.ID),
+			Qty:       uint32(p.Qty),
+			VariantId: uint32(p.VariantID),
 		})
 	}
 
@@ -249,8 +251,9 @@ func (s *CartServer) ListCarts(ctx context.Context, req *pb.ListCartRequest) (*p
 		var pbProducts []*pb.CartProduct
 		for _, p := range c.Products {
 			pbProducts = append(pbProducts, &pb.CartProduct{
-				Id:  uint32(p.ID),
-				Qty: uint32(p.Qty),
+				Id:        uint32(p.ID),
+				Qty:       uint32(p.Qty),
+				VariantId: uint32(p.VariantID),
 			})
 		}
 
@@ -315,8 +318,9 @@ func (s *CartServer) UpdateCart(ctx context.Context, req *pb.UpdateCartRequest)
 	var eventProducts []map[string]interface{}
 	for _, p := range c.Products {
 		eventProducts = append(eventProducts, map[string]interface{}{
-			"id":  p.ID,
-			"qty": p.Qty,
+			"id":         p.ID,
+			"qty":        p.Qty,
+			"variant_id": p.VariantID,
 		})
 	}
 	// event := map[string]interface{}{
@@ -334,8 +338,9 @@ func (s *CartServer) UpdateCart(ctx context.Context, req *pb.UpdateCartRequest)
 	var pbProducts []*pb.CartProduct
 	for _, p := range c.Products {
 		pbProducts = append(pbProducts, &pb.CartProduct{
-			Id:  uint32(p.ID),
-			Qty: uint32(p.Qty),
+			Id:        uint32(p.ID),
+			Qty:       uint32(p.Qty),
+			VariantId: uint32(p.VariantID),
 		})
 	}
 
@@ -420,8 +425,9 @@ func (s *CartServer) GetAllCarts(ctx context.Context, _ *emptypb.Empty) (*pb.Get
 		var pbProducts []*pb.CartProduct
 		for _, p := range c.Products {
 			pbProducts = append(pbProducts, &pb.CartProduct{
-				Id:  uint32(p.ID),
-				Qty: uint32(p.Qty),
+				Id:        uint32(p.ID),
+				Qty:       uint32(p.Qty),
+				VariantId: uint32(p.VariantID),
 			})
 		}
 
@@ -464,10 +470,10 @@ func (s *CartServer) AddToCart(ctx context.Context, req *pb.AddToCartRequest) (*
 	if err == sql.ErrNoRows {
 		// create new cart
 		products = []model.CartProduct{
-			{ID: uint(req.ProductId), Qty: uint(req.Qty)},
+			{ID: uint(req.ProductId), Qty: uint(req.Qty), VariantID: uint(req.VariantId)},
 		}
 		pbProducts := []*pb.CartProduct{
-			{Id: req.ProductId, Qty: req.Qty},
+			{Id: req.ProductId, Qty: req.Qty, VariantId: req.VariantId},
 		}
 		productsBytes, _ := json.Marshal(pbProducts)
 
@@ -488,20 +494,20 @@ func (s *CartServer) AddToCart(ctx context.Context, req *pb.AddToCartRequest) (*
 
 		found := false
 		for i := range products {
-			if products[i].ID == uint(req.ProductId) {
+			if products[i].ID == uint(req.ProductId) && products[i].VariantID == uint(req.VariantId) {
 				products[i].Qty += uint(req.Qty)
 				found = true
 				break
 			}
 		}
 		if !found {
-			products = append(products, model.CartProduct{ID: uint(req.ProductId), Qty: uint(req.Qty)})
+			products = append(products, model.CartProduct{ID: uint(req.ProductId), Qty: uint(req.Qty), VariantID: uint(req.VariantId)})
 		}
 
 		// marshal back and update
 		pbProducts := make([]*pb.CartProduct, 0, len(products))
 		for _, p := range products {
-			pbProducts = append(pbProducts, &pb.CartProduct{Id: uint32(p.ID), Qty: uint32(p.Qty)})
+			pbProducts = append(pbProducts, &pb.CartProduct{Id: uint32(p.ID), Qty: uint32(p.Qty), VariantId: uint32(p.VariantID)})
 		}
 		productsBytes, _ := json.Marshal(pbProducts)
 
@@ -525,8 +531,9 @@ func (s *CartServer) AddToCart(ctx context.Context, req *pb.AddToCartRequest) (*
 	var pbProducts []*pb.CartProduct
 	for _, p := range products {
 		pbProducts = append(pbProducts, &pb.CartProduct{
-			Id:  uint32(p.ID),
-			Qty: uint32(p.Qty),
+			Id:        uint32(p.ID),
+			Qty:       uint32(p.Qty),
+			VariantId: uint32(p.VariantID),
 		})
 	}
 
@@ -575,7 +582,7 @@ func (s *CartServer) UpdateProductQty(ctx context.Context, req *pb.UpdateProduct
 	changed := false
 	newList := make([]model.CartProduct, 0, len(products))
 	for _, p := range products {
-		if p.ID == uint(req.ProductId) {
+		if p.ID == uint(req.ProductId) && p.VariantID == uint(req.VariantId) {
 			if req.Qty == 0 {
 				// skip -> remove
 				changed = true
@@ -590,7 +597,7 @@ func (s *CartServer) UpdateProductQty(ctx context.Context, req *pb.UpdateProduct
 	if !changed {
 		// product not found and qty > 0 -> append
 		if req.Qty > 0 {
-			newList = append(newList, model.CartProduct{ID: uint(req.ProductId), Qty: uint(req.Qty)})
+			newList = append(newList, model.CartProduct{ID: uint(req.ProductId), Qty: uint(req.Qty), VariantID: uint(req.VariantId)})
 			changed = true
 		}
 	}
@@ -598,7 +605,7 @@ func (s *CartServer) UpdateProductQty(ctx context.Context, req *pb.UpdateProduct
 	// marshal and update
 	pbProducts := make([]*pb.CartProduct, 0, len(newList))
 	for _, p := range newList {
-		pbProducts = append(pbProducts, &pb.CartProduct{Id: uint32(p.ID), Qty: uint32(p.Qty)})
+		pbProducts = append(pbProducts, &pb.CartProduct{Id: uint32(p.ID), Qty: uint32(p.Qty), VariantId: uint32(p.VariantID)})
 	}
 	productsBytes, _ := json.Marshal(pbProducts)
 
@@ -662,7 +669,7 @@ func (s *CartServer) RemoveProductFromCart(ctx context.Context, req *pb.RemovePr
 	newList := []model.CartProduct{}
 	removed := false
 	for _, p := range products {
-		if p.ID == uint(req.ProductId) {
+		if p.ID == uint(req.ProductId) && p.VariantID == uint(req.VariantId) {
 			removed = true
 			continue
 		}
@@ -676,7 +683,7 @@ func (s *CartServer) RemoveProductFromCart(ctx context.Context, req *pb.RemovePr
 
 	pbProducts := make([]*pb.CartProduct, 0, len(newList))
 	for _, p := range newList {
-		pbProducts = append(pbProducts, &pb.CartProduct{Id: uint32(p.ID), Qty: uint32(p.Qty)})
+		pbProducts = append(pbProducts, &pb.CartProduct{Id: uint32(p.ID), Qty: uint32(p.Qty), VariantId: uint32(p.VariantID)})
 	}
 	productsBytes, _ := json.Marshal(pbProducts)
 
@@ -777,8 +784,9 @@ func (s *CartServer) CheckoutCart(ctx context.Context, req *pb.CheckoutRequest)
 	var pbProducts []*pb.CartProduct
 	for _, p := range products {
 		pbProducts = append(pbProducts, &pb.CartProduct{
-			Id:  uint32(p.ID),
-			Qty: uint32(p.Qty),
+			Id:        uint32(p.ID),
+			Qty:       uint32(p.Qty),
+			VariantId: uint32(p.VariantID),
 		})
 	}
 
@@ -105,3 +105,25 @@ func (p *Producer) PublishCartItemRemovedEvent(event interface{}) {
 
 	log.Printf("Published cart.item.removed event: %v", string(data))
 }
+
+// PublishErasureCompletedEvent melaporkan hasil penghapusan data user ke user-service.
+func (p *Producer) PublishErasureCompletedEvent(event interface{}) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("Failed to marshal user.erasure_completed event: %v", err)
+		return
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: "user.erasure_completed",
+		Value: sarama.ByteEncoder(data),
+	}
+
+	_, _, err = p.producer.SendMessage(msg)
+	if err != nil {
+		log.Printf("Failed to send user.erasure_completed event: %v", err)
+		return
+	}
+
+	log.Printf("📤 Published user.erasure_completed: %s", string(data))
+}
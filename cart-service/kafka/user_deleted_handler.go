@@ -0,0 +1,74 @@
+package kafka
+
+import (
+	"cart-service/cache"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+const serviceName = "cart-service"
+
+// === payload dari user-service ===
+type UserDeletedEvent struct {
+	EventType string `json:"event_type"`
+	Data      struct {
+		UserID    uint32 `json:"user_id"`
+		RequestID string `json:"request_id"`
+	} `json:"data"`
+}
+
+// publishErasureReport mengirim laporan penghapusan data (bukti per service) ke user-service.
+func publishErasureReport(producer *Producer, event UserDeletedEvent, details map[string]int64, err error) {
+	data := map[string]interface{}{
+		"request_id":   event.Data.RequestID,
+		"user_id":      event.Data.UserID,
+		"service":      serviceName,
+		"status":       "completed",
+		"details":      details,
+		"completed_at": time.Now().UTC().Format(time.RFC3339),
+	}
+	if err != nil {
+		data["status"] = "failed"
+		data["error"] = err.Error()
+	}
+
+	producer.PublishErasureCompletedEvent(map[string]interface{}{
+		"event_type": "user_erasure_completed",
+		"data":       data,
+	})
+}
+
+// UserDeletedHandler menghapus semua cart milik user (event user.deleted).
+// Cart yang sudah dibayar tercatat di transaction-service, jadi aman dihapus.
+func UserDeletedHandler(db *sql.DB, producer *Producer) func([]byte) error {
+	return func(msg []byte) error {
+		log.Printf("📥 user.deleted received: %s", string(msg))
+
+		var event UserDeletedEvent
+		if err := json.Unmarshal(msg, &event); err != nil {
+			log.Printf("❌ invalid user.deleted payload: %v", err)
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		details := map[string]int64{}
+		res, err := db.ExecContext(ctx, `DELETE FROM carts WHERE owner_id=$1`, event.Data.UserID)
+		if err == nil {
+			details["carts_deleted"], _ = res.RowsAffected()
+			cache.Redis.Del(ctx, fmt.Sprintf("carts:%d", event.Data.UserID))
+			log.Printf("✅ carts of user %d deleted", event.Data.UserID)
+		} else {
+			log.Printf("❌ failed delete carts of user %d: %v", event.Data.UserID, err)
+		}
+
+		publishErasureReport(producer, event, details, err)
+		// error dikembalikan supaya consumer mencoba ulang; laporan "failed" ditimpa saat berhasil
+		return err
+	}
+}
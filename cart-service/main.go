@@ -9,6 +9,8 @@ import (
 
 	"cart-service/grpc_server"
 	"cart-service/routes"
+	"cart-service/svcauth"
+	"context"
 	"database/sql"
 	"log"
 	"net"
@@ -55,6 +57,7 @@ func initDB() {
 
 
 func main() {
+	svcauth.Init()
 	initDB()
 	producer := kafkax.NewProducer()
 	cache.ConnectRedis()
@@ -83,7 +86,7 @@ func main() {
         Addr: redisAddr,
  	   })
 
-		grpcServer := grpc.NewServer()
+		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(svcauth.UnaryInterceptor(svcauth.ServerPolicy)))
 		cartServer := &grpc_server.CartServer{
 			DB:            SQLDB,
 			Producer: producer,
@@ -101,8 +104,10 @@ func main() {
 
 	consumer.Consume(
 		"cart.paid",
-		cartHandler.HandleCartCheckedOut,
+		func(msg []byte) error { cartHandler.HandleCartCheckedOut(msg); return nil },
 	)
+	consumer.Consume("user.deleted", kafkax.UserDeletedHandler(SQLDB, producer))
+	consumer.Start(context.Background())
 	select {}
 }
 
@@ -15,4 +15,17 @@ type Cart struct {
 type CartProduct struct {
     ID  uint `json:"id"`
     Qty uint `json:"qty"`
+    // variant (SKU) yang dipilih; 0 untuk produk tanpa variant
+    VariantID uint `json:"variant_id,omitempty"`
+}
+
+// CartLine mengidentifikasi satu baris cart: produk yang sama dengan variant berbeda
+// adalah baris yang berbeda.
+type CartLine struct {
+    ProductID uint
+    VariantID uint
+}
+
+func (p CartProduct) Line() CartLine {
+    return CartLine{ProductID: p.ID, VariantID: p.VariantID}
 }
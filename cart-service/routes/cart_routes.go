@@ -30,5 +30,5 @@ func RegisterCartRoutes(app *fiber.App, db *gorm.DB, authMiddleware fiber.Handle
 	cart.Delete("/:id", authMiddleware, cc.Delete)
 
 	// Admin only: get all carts
-	cart.Get("/all", authMiddleware, middleware.RoleRequired("admin"), cc.GetAll)
+	cart.Get("/all", authMiddleware, middleware.RequirePermission("cart:read_all"), cc.GetAll)
 }
@@ -0,0 +1,14 @@
+package svcauth
+
+// ServiceName dipakai sebagai identitas service ini di service token.
+const ServiceName = "cart-service"
+
+// ServerPolicy adalah allowlist caller untuk RPC yang di-serve service ini.
+var ServerPolicy = Policy{
+	Methods: map[string][]string{
+		"/cart.CartService/GetCart": {ServiceName, "transaction-service"},
+		// export data pribadi (DSAR)
+		"/cart.CartService/ListCarts": {ServiceName, "user-service"},
+	},
+	Default: []string{ServiceName},
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"payment-service/grpc_client"
 	pb "payment-service/proto/payment"
+	"payment-service/svcauth"
 	"strconv"
 	"time"
 
@@ -20,7 +21,7 @@ type PaymentController struct {
 }
 
 func NewPaymentController() *PaymentController {
-	conn, err := grpc.Dial("localhost:50057", grpc.WithInsecure()) // port payment-service
+	conn, err := grpc.Dial("localhost:50057", grpc.WithInsecure(), svcauth.DialOption()) // port payment-service
 	if err != nil {
 		panic("failed to connect to payment gRPC: " + err.Error())
 	}
@@ -5,6 +5,7 @@ go 1.24.3
 require (
 	github.com/IBM/sarama v1.46.3
 	github.com/gofiber/fiber/v2 v2.52.10
+	github.com/golang-jwt/jwt/v5 v5.3.0
 	github.com/redis/go-redis/v9 v9.17.2
 	google.golang.org/grpc v1.77.0
 	google.golang.org/protobuf v1.36.11
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	pb "payment-service/proto/transaction" // hasil generate proto transaction
+	"payment-service/svcauth"
 
 	"google.golang.org/grpc"
 )
@@ -15,7 +16,7 @@ type TransactionClient struct {
 }
 
 func NewTransactionClient() *TransactionClient {
-	conn, err := grpc.Dial("transaction-service:50056", grpc.WithInsecure())
+	conn, err := grpc.Dial("transaction-service:50056", grpc.WithInsecure(), svcauth.DialOption())
 	if err != nil {
 		log.Fatalf("could not connect to transaction-service: %v", err)
 	}
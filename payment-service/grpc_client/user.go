@@ -6,6 +6,7 @@ import (
 	"time"
 
 	pb "payment-service/proto/user" // hasil generate proto, copy dari user-service
+	"payment-service/svcauth"
 
 	"google.golang.org/grpc"
 )
@@ -15,7 +16,7 @@ type UserClient struct {
 }
 
 func NewUserClient() *UserClient {
-	conn, err := grpc.Dial("user-service:50051", grpc.WithInsecure())
+	conn, err := grpc.Dial("user-service:50051", grpc.WithInsecure(), svcauth.DialOption())
 	if err != nil {
 		log.Fatalf("could not connect to user-service: %v", err)
 	}
@@ -0,0 +1,77 @@
+package kafka
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"payment-service/cache"
+	"time"
+)
+
+const serviceName = "payment-service"
+
+// === payload dari user-service ===
+type UserDeletedEvent struct {
+	EventType string `json:"event_type"`
+	Data      struct {
+		UserID    uint32 `json:"user_id"`
+		RequestID string `json:"request_id"`
+	} `json:"data"`
+}
+
+// publishErasureReport mengirim laporan penghapusan data (bukti per service) ke user-service.
+func publishErasureReport(producer *Producer, event UserDeletedEvent, details map[string]int64, err error) {
+	data := map[string]interface{}{
+		"request_id":   event.Data.RequestID,
+		"user_id":      event.Data.UserID,
+		"service":      serviceName,
+		"status":       "completed",
+		"details":      details,
+		"completed_at": time.Now().UTC().Format(time.RFC3339),
+	}
+	if err != nil {
+		data["status"] = "failed"
+		data["error"] = err.Error()
+	}
+
+	producer.PublishErasureCompletedEvent(map[string]interface{}{
+		"event_type": "user_erasure_completed",
+		"data":       data,
+	})
+}
+
+// UserDeletedHandler menangani event user.deleted. Payment hanya menyimpan
+// user_id, nominal dan metode pembayaran (tanpa data pribadi lain), dan wajib
+// disimpan untuk pembukuan; jadi data tidak diubah, hanya cache yang dibuang
+// dan jumlah record yang disimpan dicatat di laporan.
+func UserDeletedHandler(db *sql.DB, producer *Producer) func([]byte) error {
+	return func(msg []byte) error {
+		log.Printf("📥 user.deleted received: %s", string(msg))
+
+		var event UserDeletedEvent
+		if err := json.Unmarshal(msg, &event); err != nil {
+			log.Printf("❌ invalid user.deleted payload: %v", err)
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		var retained int64
+		err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM payments WHERE user_id=$1`, event.Data.UserID).Scan(&retained)
+		details := map[string]int64{}
+		if err == nil {
+			details["payments_retained"] = retained
+			cache.Redis.Del(ctx, fmt.Sprintf("payments:%d", event.Data.UserID), "payments:all")
+			log.Printf("✅ payments of user %d retained without personal data (%d)", event.Data.UserID, retained)
+		} else {
+			log.Printf("❌ failed count payments of user %d: %v", event.Data.UserID, err)
+		}
+
+		publishErasureReport(producer, event, details, err)
+		// error dikembalikan supaya consumer mencoba ulang; laporan "failed" ditimpa saat berhasil
+		return err
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"payment-service/model"
 	pb "payment-service/proto/payment"
 	"payment-service/routes"
+	"payment-service/svcauth"
 
+	"context"
 	"database/sql"
 	"log"
 	"net"
@@ -64,6 +66,7 @@ func initDB() {
 }
 
 func main() {
+	svcauth.Init()
 	initDB()
 
 	// kafka producer
@@ -104,7 +107,7 @@ func main() {
 			Addr: redisAddr,
 		})
 
-		grpcServer := grpc.NewServer()
+		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(svcauth.UnaryInterceptor(svcauth.ServerPolicy)))
 
 		paymentServer := grpc_server.NewPaymentServer(
 			SQLDB,
@@ -120,6 +123,13 @@ func main() {
 		}
 	}()
 
+	// ======================
+	// KAFKA CONSUMER
+	// ======================
+	consumer := kafkax.NewConsumer()
+	consumer.Consume("user.deleted", kafkax.UserDeletedHandler(SQLDB, producer))
+	consumer.Start(context.Background())
+
 	select {}
 }
 
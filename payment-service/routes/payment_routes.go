@@ -26,7 +26,7 @@ func RegisterPaymentRoutes(app *fiber.App, authMiddleware fiber.Handler) {
 	p.Get(
 		"/all",
 		authMiddleware,
-		middleware.RoleRequired("admin"),
+		middleware.RequirePermission("payment:read_all"),
 		pc.ListAll,
 	)
 
@@ -0,0 +1,13 @@
+package svcauth
+
+// ServiceName dipakai sebagai identitas service ini di service token.
+const ServiceName = "payment-service"
+
+// ServerPolicy adalah allowlist caller untuk RPC yang di-serve service ini.
+var ServerPolicy = Policy{
+	Methods: map[string][]string{
+		// export data pribadi (DSAR)
+		"/payment.PaymentService/ListUserPayments": {ServiceName, "user-service"},
+	},
+	Default: []string{ServiceName},
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	pb "product-service/proto/product"
+
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// categoryError memetakan error gRPC kategori ke status HTTP.
+func categoryError(c *fiber.Ctx, err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return c.Status(400).JSON(fiber.Map{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return c.Status(409).JSON(fiber.Map{"error": status.Convert(err).Message()})
+	}
+	return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+}
+
+// ===============================
+//         CATEGORY TREE
+// ===============================
+func (pc *ProductController) GetCategoryTree(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.GetCategoryTree(ctx, &emptypb.Empty{})
+	if err != nil {
+		return categoryError(c, err)
+	}
+
+	return c.JSON(resp.Categories)
+}
+
+func (pc *ProductController) GetCategorySubtree(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.GetCategorySubtree(ctx, &pb.GetCategoryRequest{Id: uint32(id)})
+	if err != nil {
+		return categoryError(c, err)
+	}
+
+	return c.JSON(resp.Category)
+}
+
+func (pc *ProductController) GetCategoryBreadcrumbs(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.GetCategoryBreadcrumbs(ctx, &pb.GetCategoryRequest{Id: uint32(id)})
+	if err != nil {
+		return categoryError(c, err)
+	}
+
+	return c.JSON(resp.Categories)
+}
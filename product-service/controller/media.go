@@ -0,0 +1,139 @@
+package controller
+
+import (
+	pb "product-service/proto/product"
+
+	"context"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mediaError memetakan error gRPC media ke status HTTP.
+func mediaError(c *fiber.Ctx, err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return c.Status(400).JSON(fiber.Map{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+	case codes.Unavailable:
+		return c.Status(503).JSON(fiber.Map{"error": status.Convert(err).Message()})
+	}
+	return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+}
+
+// ===============================
+//         MEDIA
+// ===============================
+
+// UploadImage menerima multipart form: file (wajib), variant_id dan alt_text (opsional).
+func (pc *ProductController) UploadImage(c *fiber.Ctx) error {
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	variantID := 0
+	if v := c.FormValue("variant_id"); v != "" {
+		if variantID, err = strconv.Atoi(v); err != nil || variantID < 0 {
+			return c.Status(400).JSON(fiber.Map{"error": "invalid variant_id"})
+		}
+	}
+
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "file is required"})
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid file"})
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid file"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.UploadProductImage(ctx, &pb.UploadProductImageRequest{
+		ProductId: uint32(productID),
+		VariantId: uint32(variantID),
+		Data:      data,
+		AltText:   c.FormValue("alt_text"),
+	})
+	if err != nil {
+		return mediaError(c, err)
+	}
+
+	return c.Status(201).JSON(resp.Image)
+}
+
+func (pc *ProductController) ListImages(c *fiber.Ctx) error {
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.ListProductImages(ctx, &pb.ListProductImagesRequest{ProductId: uint32(productID)})
+	if err != nil {
+		return mediaError(c, err)
+	}
+
+	return c.JSON(resp.Images)
+}
+
+// ReorderImages mengatur ulang urutan galeri, body {"variant_id": 0, "image_ids": [3, 1, 2]}.
+func (pc *ProductController) ReorderImages(c *fiber.Ctx) error {
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var body struct {
+		VariantID uint32   `json:"variant_id"`
+		ImageIDs  []uint32 `json:"image_ids"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.ReorderProductImages(ctx, &pb.ReorderProductImagesRequest{
+		ProductId: uint32(productID),
+		VariantId: body.VariantID,
+		ImageIds:  body.ImageIDs,
+	})
+	if err != nil {
+		return mediaError(c, err)
+	}
+
+	return c.JSON(resp.Images)
+}
+
+func (pc *ProductController) DeleteImage(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("image_id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid image_id"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.DeleteProductImage(ctx, &pb.DeleteProductImageRequest{Id: uint32(id)})
+	if err != nil {
+		return mediaError(c, err)
+	}
+
+	return c.JSON(resp)
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"product-service/grpc_client"
 	pb "product-service/proto/product"
+	"product-service/svcauth"
 
 	"context"
 	"strconv"
@@ -26,7 +27,17 @@ func (pc *ProductController) ListProducts(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	resp, err := pc.Client.ListProducts(ctx, &emptypb.Empty{})
+	// ?category_id=&include_descendants=true untuk ikut produk di sub-kategori
+	req := &pb.ListProductsRequest{IncludeDescendants: c.QueryBool("include_descendants")}
+	if v := c.Query("category_id"); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil || id <= 0 {
+			return c.Status(400).JSON(fiber.Map{"error": "invalid category_id"})
+		}
+		req.CategoryId = uint32(id)
+	}
+
+	resp, err := pc.Client.ListProducts(ctx, req)
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
@@ -151,7 +162,10 @@ func (pc *ProductController) DeleteProduct(c *fiber.Ctx) error {
 // ===============================
 func (pc *ProductController) CreateCategory(c *fiber.Ctx) error {
 	var body struct {
-		Name string `json:"name"`
+		Name      string `json:"name"`
+		ParentID  uint32 `json:"parent_id"`
+		Slug      string `json:"slug"`
+		SortOrder int32  `json:"sort_order"`
 	}
 
 	if err := c.BodyParser(&body); err != nil {
@@ -162,10 +176,13 @@ func (pc *ProductController) CreateCategory(c *fiber.Ctx) error {
 	defer cancel()
 
 	resp, err := pc.Client.CreateCategory(ctx, &pb.CreateCategoryRequest{
-		Name: body.Name,
+		Name:      body.Name,
+		ParentId:  body.ParentID,
+		Slug:      body.Slug,
+		SortOrder: body.SortOrder,
 	})
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return categoryError(c, err)
 	}
 
 	return c.JSON(resp.Category)
@@ -189,8 +206,12 @@ func (pc *ProductController) UpdateCategory(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
 	}
 
+	// parent_id / sort_order yang tidak dikirim tidak diubah; parent_id 0 = pindah ke root
 	var body struct {
-		Name string `json:"name"`
+		Name      string  `json:"name"`
+		ParentID  *uint32 `json:"parent_id"`
+		Slug      string  `json:"slug"`
+		SortOrder *int32  `json:"sort_order"`
 	}
 
 	if err := c.BodyParser(&body); err != nil {
@@ -201,11 +222,14 @@ func (pc *ProductController) UpdateCategory(c *fiber.Ctx) error {
 	defer cancel()
 
 	resp, err := pc.Client.UpdateCategory(ctx, &pb.UpdateCategoryRequest{
-		Id:   uint32(id),
-		Name: body.Name,
+		Id:        uint32(id),
+		Name:      body.Name,
+		ParentId:  body.ParentID,
+		Slug:      body.Slug,
+		SortOrder: body.SortOrder,
 	})
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return categoryError(c, err)
 	}
 
 	return c.JSON(resp.Category)
@@ -223,7 +247,7 @@ func (pc *ProductController) DeleteCategory(c *fiber.Ctx) error {
 		Id: uint32(id),
 	})
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return categoryError(c, err)
 	}
 
 	return c.JSON(resp)
@@ -280,7 +304,9 @@ func (pc *ProductController) GetStock(c *fiber.Ctx) error {
 //         INIT CONTROLLER
 // ===============================
 func NewProductController() *ProductController {
-	conn, err := grpc.Dial("localhost:50054", grpc.WithInsecure())
+	// pesan upload gambar lebih besar dari batas default 4MB gRPC
+	conn, err := grpc.Dial("localhost:50054", grpc.WithInsecure(), svcauth.DialOption(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(64<<20)))
 	if err != nil {
 		panic("failed to connect to product gRPC: " + err.Error())
 	}
@@ -0,0 +1,212 @@
+package controller
+
+import (
+	pb "product-service/proto/product"
+
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// variantError memetakan error gRPC variant / option type ke status HTTP.
+func variantError(c *fiber.Ctx, err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return c.Status(400).JSON(fiber.Map{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return c.Status(409).JSON(fiber.Map{"error": status.Convert(err).Message()})
+	}
+	return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+}
+
+// ===============================
+//         OPTION TYPE
+// ===============================
+func (pc *ProductController) CreateOptionType(c *fiber.Ctx) error {
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var body struct {
+		Name   string   `json:"name"`
+		Values []string `json:"values"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.CreateOptionType(ctx, &pb.CreateOptionTypeRequest{
+		ProductId: uint32(productID),
+		Name:      body.Name,
+		Values:    body.Values,
+	})
+	if err != nil {
+		return variantError(c, err)
+	}
+
+	return c.Status(201).JSON(resp.OptionType)
+}
+
+func (pc *ProductController) ListOptionTypes(c *fiber.Ctx) error {
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.ListOptionTypes(ctx, &pb.ListOptionTypesRequest{ProductId: uint32(productID)})
+	if err != nil {
+		return variantError(c, err)
+	}
+
+	return c.JSON(resp.OptionTypes)
+}
+
+func (pc *ProductController) DeleteOptionType(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("option_id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid option_id"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.DeleteOptionType(ctx, &pb.DeleteOptionTypeRequest{Id: uint32(id)})
+	if err != nil {
+		return variantError(c, err)
+	}
+
+	return c.JSON(resp)
+}
+
+// ===============================
+//         VARIANT
+// ===============================
+type variantBody struct {
+	SKU     string            `json:"sku"`
+	Barcode string            `json:"barcode"`
+	Price   uint32            `json:"price"`
+	Stock   int32             `json:"stock"`
+	Options map[string]string `json:"options"`
+}
+
+func (pc *ProductController) CreateVariant(c *fiber.Ctx) error {
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	var body variantBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.CreateVariant(ctx, &pb.CreateVariantRequest{
+		ProductId: uint32(productID),
+		Sku:       body.SKU,
+		Barcode:   body.Barcode,
+		Price:     body.Price,
+		Stock:     body.Stock,
+		Options:   body.Options,
+	})
+	if err != nil {
+		return variantError(c, err)
+	}
+
+	return c.Status(201).JSON(resp.Variant)
+}
+
+func (pc *ProductController) ListVariants(c *fiber.Ctx) error {
+	productID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.ListVariants(ctx, &pb.ListVariantsRequest{ProductId: uint32(productID)})
+	if err != nil {
+		return variantError(c, err)
+	}
+
+	return c.JSON(resp.Variants)
+}
+
+func (pc *ProductController) GetVariant(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("variant_id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid variant_id"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.GetVariant(ctx, &pb.GetVariantRequest{Id: uint32(id)})
+	if err != nil {
+		return variantError(c, err)
+	}
+
+	return c.JSON(resp.Variant)
+}
+
+func (pc *ProductController) UpdateVariant(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("variant_id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid variant_id"})
+	}
+
+	var body variantBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid payload"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.UpdateVariant(ctx, &pb.UpdateVariantRequest{
+		Id:      uint32(id),
+		Sku:     body.SKU,
+		Barcode: body.Barcode,
+		Price:   body.Price,
+		Stock:   body.Stock,
+		Options: body.Options,
+	})
+	if err != nil {
+		return variantError(c, err)
+	}
+
+	return c.JSON(resp.Variant)
+}
+
+func (pc *ProductController) DeleteVariant(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("variant_id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid variant_id"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pc.Client.DeleteVariant(ctx, &pb.DeleteVariantRequest{Id: uint32(id)})
+	if err != nil {
+		return variantError(c, err)
+	}
+
+	return c.JSON(resp)
+}
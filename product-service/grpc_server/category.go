@@ -0,0 +1,334 @@
+package grpc_server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+
+	"product-service/model"
+	pb "product-service/proto/product"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const categoryColumns = `id, name, parent_id, slug, sort_order`
+
+// categoryDescendantsQuery mengembalikan id kategori $1 beserta seluruh turunannya.
+// UNION (bukan UNION ALL) supaya tetap berhenti kalau data lama terlanjur membentuk siklus.
+const categoryDescendantsQuery = `
+	WITH RECURSIVE tree AS (
+		SELECT id FROM categories WHERE id = $1
+		UNION
+		SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+	)
+	SELECT id FROM tree`
+
+// queryer dipenuhi *sql.DB dan *sql.Tx
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func scanCategory(row rowScanner, c *model.Category) error {
+	return row.Scan(&c.ID, &c.Name, &c.ParentID, &c.Slug, &c.SortOrder)
+}
+
+func toProtoCategory(c *model.Category) *pb.Category {
+	pc := &pb.Category{
+		Id:        uint32(c.ID),
+		Name:      c.Name,
+		Slug:      c.Slug,
+		SortOrder: int32(c.SortOrder),
+	}
+	if c.ParentID != nil {
+		pc.ParentId = uint32(*c.ParentID)
+	}
+	return pc
+}
+
+func (s *ProductServer) GetCategoryTree(ctx context.Context, _ *emptypb.Empty) (*pb.CategoryTreeResponse, error) {
+	children, err := s.loadCategoryTree(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	return &pb.CategoryTreeResponse{Categories: children[0]}, nil
+}
+
+func (s *ProductServer) GetCategorySubtree(ctx context.Context, req *pb.GetCategoryRequest) (*pb.CategoryResponse, error) {
+	var c model.Category
+	err := scanCategory(s.DB.QueryRowContext(ctx, `SELECT `+categoryColumns+` FROM categories WHERE id=$1`, req.Id), &c)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "category not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	children, err := s.loadCategoryTree(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	root := toProtoCategory(&c)
+	root.Children = children[req.Id]
+	return &pb.CategoryResponse{Category: root}, nil
+}
+
+// GetCategoryBreadcrumbs mengembalikan jalur dari kategori root sampai kategori yang diminta.
+func (s *ProductServer) GetCategoryBreadcrumbs(ctx context.Context, req *pb.GetCategoryRequest) (*pb.ListCategoriesResponse, error) {
+	path, err := categoryAncestors(ctx, s.DB, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	if len(path) == 0 {
+		return nil, status.Errorf(codes.NotFound, "category not found")
+	}
+
+	resp := &pb.ListCategoriesResponse{}
+	for _, c := range path {
+		resp.Categories = append(resp.Categories, toProtoCategory(c))
+	}
+	return resp, nil
+}
+
+// ====================== HELPER ======================
+
+// loadCategoryTree memuat semua kategori dan mengelompokkannya per parent
+// (key 0 = root). Node di dalam map sudah saling terhubung lewat Children.
+func (s *ProductServer) loadCategoryTree(ctx context.Context) (map[uint32][]*pb.Category, error) {
+	rows, err := s.DB.QueryContext(ctx, `SELECT `+categoryColumns+` FROM categories ORDER BY sort_order, name, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var nodes []*pb.Category
+	for rows.Next() {
+		var c model.Category
+		if err := scanCategory(rows, &c); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, toProtoCategory(&c))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	children := map[uint32][]*pb.Category{}
+	for _, n := range nodes {
+		children[n.ParentId] = append(children[n.ParentId], n)
+	}
+	for _, n := range nodes {
+		n.Children = children[n.Id]
+	}
+	return children, nil
+}
+
+// categoryAncestors mengembalikan kategori id beserta semua leluhurnya, urut dari root.
+// Kosong kalau kategori tidak ada.
+func categoryAncestors(ctx context.Context, q queryer, id uint32) ([]*model.Category, error) {
+	rows, err := q.QueryContext(ctx, `
+	WITH RECURSIVE path AS (
+		SELECT `+categoryColumns+`, 0 AS depth FROM categories WHERE id = $1
+		UNION ALL
+		SELECT c.id, c.name, c.parent_id, c.slug, c.sort_order, p.depth + 1
+		FROM categories c JOIN path p ON c.id = p.parent_id
+		WHERE p.depth < 100
+	)
+	SELECT `+categoryColumns+` FROM path ORDER BY depth DESC`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var path []*model.Category
+	for rows.Next() {
+		var c model.Category
+		if err := scanCategory(rows, &c); err != nil {
+			return nil, err
+		}
+		path = append(path, &c)
+	}
+	return path, rows.Err()
+}
+
+// categoryPath adalah id leluhur produk sampai kategorinya sendiri; diindeks search-service
+// sebagai category_path supaya filter kategori bisa ikut sub-kategori.
+func (s *ProductServer) categoryPath(ctx context.Context, categoryID uint) []uint {
+	path, err := categoryAncestors(ctx, s.DB, uint32(categoryID))
+	if err != nil {
+		log.Printf("failed to load category path of %d: %v", categoryID, err)
+	}
+	ids := []uint{}
+	for _, c := range path {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+// checkCategoryParent memastikan parent ada dan (untuk update) bukan kategori itu sendiri
+// atau salah satu turunannya.
+func checkCategoryParent(ctx context.Context, q queryer, id, parentID uint32) error {
+	if parentID == 0 {
+		return nil
+	}
+
+	var exists bool
+	err := q.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM categories WHERE id=$1)`, parentID).Scan(&exists)
+	if err != nil {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !exists {
+		return status.Errorf(codes.InvalidArgument, "parent category %d not found", parentID)
+	}
+	if id == 0 {
+		return nil
+	}
+
+	var cycle bool
+	err = q.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM (`+categoryDescendantsQuery+`) d WHERE d.id = $2)`, id, parentID,
+	).Scan(&cycle)
+	if err != nil {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if cycle {
+		return status.Errorf(codes.InvalidArgument, "category cannot be moved under itself or its descendants")
+	}
+	return nil
+}
+
+// categorySlug menormalkan slug yang diminta; kalau kosong, slug dibentuk dari name
+// dan diberi akhiran angka bila sudah dipakai kategori lain.
+func categorySlug(ctx context.Context, q queryer, id uint32, slug, name string) (string, error) {
+	if slug != "" {
+		slug = slugify(slug)
+		if slug == "" {
+			return "", status.Errorf(codes.InvalidArgument, "slug must contain letters or digits")
+		}
+		taken, err := slugTaken(ctx, q, id, slug)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		if taken {
+			return "", status.Errorf(codes.AlreadyExists, "slug %q already used by another category", slug)
+		}
+		return slug, nil
+	}
+
+	s, err := uniqueSlug(ctx, q, id, name)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return s, nil
+}
+
+func uniqueSlug(ctx context.Context, q queryer, id uint32, name string) (string, error) {
+	base := slugify(name)
+	if base == "" {
+		base = "category"
+	}
+	for n := 1; ; n++ {
+		slug := base
+		if n > 1 {
+			slug = fmt.Sprintf("%s-%d", base, n)
+		}
+		taken, err := slugTaken(ctx, q, id, slug)
+		if err != nil || !taken {
+			return slug, err
+		}
+	}
+}
+
+func slugTaken(ctx context.Context, q queryer, id uint32, slug string) (bool, error) {
+	var taken bool
+	err := q.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM categories WHERE slug=$1 AND id<>$2)`, slug, id,
+	).Scan(&taken)
+	return taken, err
+}
+
+// slugify: huruf kecil dan angka dipertahankan, karakter lain jadi satu tanda "-"
+func slugify(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return b.String()
+}
+
+// reindexCategoryProducts mengirim ulang product_updated untuk semua produk di subtree
+// kategori yang dipindah, supaya category_path di search ikut berubah.
+func (s *ProductServer) reindexCategoryProducts(ctx context.Context, categoryID uint32) {
+	rows, err := s.DB.QueryContext(ctx,
+		`SELECT id FROM products WHERE category_id IN (`+categoryDescendantsQuery+`)`, categoryID)
+	if err != nil {
+		log.Printf("failed to list products of category %d: %v", categoryID, err)
+		return
+	}
+	var ids []uint32
+	for rows.Next() {
+		var id uint32
+		if err := rows.Scan(&id); err != nil {
+			log.Printf("failed to scan product of category %d: %v", categoryID, err)
+			break
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	for _, id := range ids {
+		p, err := s.loadProduct(ctx, id)
+		if err != nil {
+			log.Printf("failed to load product %d for product_updated: %v", id, err)
+			continue
+		}
+		s.Producer.PublishProductUpdatedEvent(map[string]interface{}{
+			"event_type": "product_updated",
+			"data":       s.productEventData(ctx, p),
+		})
+	}
+}
+
+// BackfillCategorySlugs mengisi slug kategori lama (sebelum ada kolom slug) sebelum
+// unique index dibuat.
+func BackfillCategorySlugs(ctx context.Context, db *sql.DB) error {
+	rows, err := db.QueryContext(ctx, `SELECT id, name FROM categories WHERE slug = '' ORDER BY id`)
+	if err != nil {
+		return err
+	}
+	var pending []model.Category
+	for rows.Next() {
+		var c model.Category
+		if err := rows.Scan(&c.ID, &c.Name); err != nil {
+			rows.Close()
+			return err
+		}
+		pending = append(pending, c)
+	}
+	rows.Close()
+
+	for _, c := range pending {
+		slug, err := uniqueSlug(ctx, db, uint32(c.ID), c.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `UPDATE categories SET slug=$1 WHERE id=$2`, slug, c.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
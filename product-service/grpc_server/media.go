@@ -0,0 +1,263 @@
+package grpc_server
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"product-service/media"
+	"product-service/model"
+	pb "product-service/proto/product"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// kolom primary_image_url & primary_thumbnail_url untuk SELECT / RETURNING dari tabel products
+const primaryImageColumns = `COALESCE((SELECT i.url FROM product_images i WHERE i.product_id = products.id AND i.variant_id = 0 ORDER BY i.position, i.id LIMIT 1), ''),
+	COALESCE((SELECT i.thumbnail_url FROM product_images i WHERE i.product_id = products.id AND i.variant_id = 0 ORDER BY i.position, i.id LIMIT 1), '')`
+
+const imageColumns = `id, product_id, variant_id, position, storage_key, thumbnail_key, url, thumbnail_url,
+	content_type, width, height, size_bytes, alt_text, created_at`
+
+func scanImage(row rowScanner, i *model.ProductImage) error {
+	return row.Scan(&i.ID, &i.ProductID, &i.VariantID, &i.Position, &i.StorageKey, &i.ThumbnailKey, &i.URL, &i.ThumbnailURL,
+		&i.ContentType, &i.Width, &i.Height, &i.SizeBytes, &i.AltText, &i.CreatedAt)
+}
+
+func (s *ProductServer) UploadProductImage(ctx context.Context, req *pb.UploadProductImageRequest) (*pb.ProductImageResponse, error) {
+	if err := s.checkGallery(ctx, req.ProductId, req.VariantId); err != nil {
+		return nil, err
+	}
+
+	img, err := media.Process(req.Data, s.MaxImageBytes, s.MaxImagePixels, s.ThumbnailSize)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	base := fmt.Sprintf("products/%d/%s", req.ProductId, randomName())
+	key, thumbKey := base+img.Ext, base+"_thumb.jpg"
+
+	if err := s.Storage.Put(ctx, key, req.Data, img.ContentType); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to store image: %v", err)
+	}
+	if err := s.Storage.Put(ctx, thumbKey, img.Thumbnail, "image/jpeg"); err != nil {
+		s.removeObjects(key)
+		return nil, status.Errorf(codes.Unavailable, "failed to store thumbnail: %v", err)
+	}
+
+	// gambar baru masuk ke akhir galeri
+	var i model.ProductImage
+	err = scanImage(s.DB.QueryRowContext(ctx, `
+	INSERT INTO product_images (product_id, variant_id, position, storage_key, thumbnail_key, url, thumbnail_url,
+		content_type, width, height, size_bytes, alt_text, created_at)
+	SELECT $1, $2, COALESCE(MAX(position) + 1, 0), $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW()
+	FROM product_images WHERE product_id=$1 AND variant_id=$2
+	RETURNING `+imageColumns,
+		req.ProductId, req.VariantId, key, thumbKey, s.Storage.URL(key), s.Storage.URL(thumbKey),
+		img.ContentType, img.Width, img.Height, len(req.Data), req.AltText,
+	), &i)
+	if err != nil {
+		s.removeObjects(key, thumbKey)
+		return nil, status.Errorf(codes.Internal, "failed to insert: %v", err)
+	}
+
+	if i.VariantID == 0 && i.Position == 0 {
+		s.primaryImageChanged(ctx, req.ProductId)
+	}
+
+	return &pb.ProductImageResponse{Image: toProtoImage(&i)}, nil
+}
+
+func (s *ProductServer) ListProductImages(ctx context.Context, req *pb.ListProductImagesRequest) (*pb.ListProductImagesResponse, error) {
+	rows, err := s.DB.QueryContext(ctx,
+		`SELECT `+imageColumns+` FROM product_images WHERE product_id=$1 ORDER BY variant_id, position, id`, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &pb.ListProductImagesResponse{}
+	for rows.Next() {
+		var i model.ProductImage
+		if err := scanImage(rows, &i); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan error: %v", err)
+		}
+		resp.Images = append(resp.Images, toProtoImage(&i))
+	}
+	return resp, nil
+}
+
+func (s *ProductServer) ReorderProductImages(ctx context.Context, req *pb.ReorderProductImagesRequest) (*pb.ListProductImagesResponse, error) {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx,
+		`SELECT id FROM product_images WHERE product_id=$1 AND variant_id=$2 FOR UPDATE`, req.ProductId, req.VariantId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	current := map[uint32]bool{}
+	for rows.Next() {
+		var id uint32
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "scan error: %v", err)
+		}
+		current[id] = true
+	}
+	rows.Close()
+
+	// urutan baru harus menyebut setiap gambar galeri tepat satu kali
+	if len(req.ImageIds) != len(current) {
+		return nil, status.Errorf(codes.InvalidArgument, "image_ids must list all %d images of the gallery", len(current))
+	}
+	seen := map[uint32]bool{}
+	for _, id := range req.ImageIds {
+		if !current[id] || seen[id] {
+			return nil, status.Errorf(codes.InvalidArgument, "image %d is not in the gallery or listed twice", id)
+		}
+		seen[id] = true
+	}
+
+	for pos, id := range req.ImageIds {
+		if _, err := tx.ExecContext(ctx, `UPDATE product_images SET position=$1 WHERE id=$2`, pos, id); err != nil {
+			return nil, status.Errorf(codes.Internal, "update error: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit error: %v", err)
+	}
+
+	if req.VariantId == 0 && len(req.ImageIds) > 0 {
+		s.primaryImageChanged(ctx, req.ProductId)
+	}
+
+	return s.ListProductImages(ctx, &pb.ListProductImagesRequest{ProductId: req.ProductId})
+}
+
+func (s *ProductServer) DeleteProductImage(ctx context.Context, req *pb.DeleteProductImageRequest) (*pb.DeleteProductImageResponse, error) {
+	var i model.ProductImage
+	err := scanImage(s.DB.QueryRowContext(ctx,
+		`DELETE FROM product_images WHERE id=$1 RETURNING `+imageColumns, req.Id), &i)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "image not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete error: %v", err)
+	}
+
+	// rapatkan urutan supaya gambar berikutnya jadi position 0
+	if _, err := s.DB.ExecContext(ctx,
+		`UPDATE product_images SET position = position - 1 WHERE product_id=$1 AND variant_id=$2 AND position > $3`,
+		i.ProductID, i.VariantID, i.Position); err != nil {
+		log.Printf("failed to compact gallery of product %d: %v", i.ProductID, err)
+	}
+
+	s.removeObjects(i.StorageKey, i.ThumbnailKey)
+
+	if i.VariantID == 0 && i.Position == 0 {
+		s.primaryImageChanged(ctx, uint32(i.ProductID))
+	}
+
+	return &pb.DeleteProductImageResponse{Message: "Image deleted successfully"}, nil
+}
+
+// ====================== HELPER ======================
+
+// checkGallery memastikan produk ada dan variant (kalau diisi) milik produk tersebut.
+func (s *ProductServer) checkGallery(ctx context.Context, productID, variantID uint32) error {
+	if err := s.checkProductExists(ctx, productID); err != nil {
+		return err
+	}
+	if variantID == 0 {
+		return nil
+	}
+
+	var owner uint32
+	err := s.DB.QueryRowContext(ctx, `SELECT product_id FROM product_variants WHERE id=$1`, variantID).Scan(&owner)
+	if err == sql.ErrNoRows || (err == nil && owner != productID) {
+		return status.Errorf(codes.NotFound, "variant not found")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	return nil
+}
+
+// primaryImageChanged membuang cache list dan mengirim product_updated dengan URL gambar
+// utama yang baru, supaya kartu produk di search ikut berubah.
+func (s *ProductServer) primaryImageChanged(ctx context.Context, productID uint32) {
+	s.Redis.Del(ctx, "products:all")
+
+	p, err := s.loadProduct(ctx, productID)
+	if err != nil {
+		log.Printf("failed to load product %d for product_updated: %v", productID, err)
+		return
+	}
+	s.Producer.PublishProductUpdatedEvent(map[string]interface{}{
+		"event_type": "product_updated",
+		"data":       s.productEventData(ctx, p),
+	})
+}
+
+// deleteImages menghapus baris gambar yang cocok dengan where di dalam tx dan mengembalikan
+// key storage-nya; file baru dihapus (removeObjects) setelah commit.
+func deleteImages(ctx context.Context, tx *sql.Tx, where string, args ...interface{}) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `DELETE FROM product_images WHERE `+where+` RETURNING storage_key, thumbnail_key`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key, thumbKey string
+		if err := rows.Scan(&key, &thumbKey); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key, thumbKey)
+	}
+	return keys, rows.Err()
+}
+
+// removeObjects menghapus file di storage; gagal hanya di-log karena barisnya sudah tidak dirujuk.
+func (s *ProductServer) removeObjects(keys ...string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, key := range keys {
+		if err := s.Storage.Delete(ctx, key); err != nil {
+			log.Printf("failed to delete media %s: %v", key, err)
+		}
+	}
+}
+
+func randomName() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func toProtoImage(i *model.ProductImage) *pb.ProductImage {
+	return &pb.ProductImage{
+		Id:           uint32(i.ID),
+		ProductId:    uint32(i.ProductID),
+		VariantId:    uint32(i.VariantID),
+		Position:     uint32(i.Position),
+		Url:          i.URL,
+		ThumbnailUrl: i.ThumbnailURL,
+		ContentType:  i.ContentType,
+		Width:        uint32(i.Width),
+		Height:       uint32(i.Height),
+		SizeBytes:    uint32(i.SizeBytes),
+		AltText:      i.AltText,
+		CreatedAt:    i.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -5,11 +5,13 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	kafka "product-service/kafka"
 	"product-service/model"
 	pb "product-service/proto/product"
+	"product-service/storage"
 
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc/codes"
@@ -22,6 +24,12 @@ type ProductServer struct {
 	DB       *sql.DB
 	Producer *kafka.Producer
 	Redis    *redis.Client
+
+	// media produk
+	Storage       storage.Storage
+	MaxImageBytes  int
+	MaxImagePixels int
+	ThumbnailSize  int
 }
 
 // ====================== HELPER ======================
@@ -31,15 +39,36 @@ func toProtoProduct(p *model.Product) *pb.Product {
 		return nil
 	}
 	return &pb.Product{
-		Id:         uint32(p.ID),
-		Name:       p.Name,
-		Desc:       p.Desc,
-		Price:      uint32(p.Price),
-		CategoryId: uint32(p.CategoryID),
-		CreatedAt:  p.CreatedAt.Format(time.RFC3339),
+		Id:          uint32(p.ID),
+		Name:        p.Name,
+		Desc:        p.Desc,
+		Price:       uint32(p.Price),
+		CategoryId:  uint32(p.CategoryID),
+		CreatedAt:   p.CreatedAt.Format(time.RFC3339),
+		HasVariants: p.HasVariants,
+
+		PrimaryImageUrl:     p.PrimaryImageURL,
+		PrimaryThumbnailUrl: p.PrimaryThumbnailURL,
+	}
+}
+
+// productEventData adalah payload product_created / product_updated (diindeks search-service).
+func (s *ProductServer) productEventData(ctx context.Context, p *model.Product) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    p.ID,
+		"name":                  p.Name,
+		"desc":                  p.Desc,
+		"price":                 p.Price,
+		"category_id":           p.CategoryID,
+		"category_path":         s.categoryPath(ctx, p.CategoryID),
+		"primary_image_url":     p.PrimaryImageURL,
+		"primary_thumbnail_url": p.PrimaryThumbnailURL,
 	}
 }
 
+// kolom has_variants untuk SELECT / RETURNING dari tabel products
+const hasVariantsColumn = `EXISTS(SELECT 1 FROM product_variants v WHERE v.product_id = products.id)`
+
 // product
 
 // CREATE
@@ -67,13 +96,7 @@ func (s *ProductServer) CreateProduct(ctx context.Context, req *pb.CreateProduct
 	// publish event
 	event := map[string]interface{}{
 		"event_type": "product_created",
-		"data": map[string]interface{}{
-			"id":          p.ID,
-			"name":        p.Name,
-			"desc":        p.Desc,
-			"price":       p.Price,
-			"category_id": p.CategoryID,
-		},
+		"data":       s.productEventData(ctx, &p),
 	}
 	s.Producer.PublishProductCreatedEvent(event)
 
@@ -82,15 +105,7 @@ func (s *ProductServer) CreateProduct(ctx context.Context, req *pb.CreateProduct
 
 // GET SINGLE
 func (s *ProductServer) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.ProductResponse, error) {
-	query := `
-	SELECT id, name, "desc", price, category_id, created_at
-	FROM products WHERE id = $1
-	`
-
-	var p model.Product
-	err := s.DB.QueryRowContext(ctx, query, req.Id).
-		Scan(&p.ID, &p.Name, &p.Desc, &p.Price, &p.CategoryID, &p.CreatedAt)
-
+	p, err := s.loadProduct(ctx, req.Id)
 	if err == sql.ErrNoRows {
 		return nil, status.Errorf(codes.NotFound, "product not found")
 	}
@@ -98,11 +113,31 @@ func (s *ProductServer) GetProduct(ctx context.Context, req *pb.GetProductReques
 		return nil, status.Errorf(codes.Internal, "query error: %v", err)
 	}
 
-	return &pb.ProductResponse{Product: toProtoProduct(&p)}, nil
+	return &pb.ProductResponse{Product: toProtoProduct(p)}, nil
+}
+
+func (s *ProductServer) loadProduct(ctx context.Context, id uint32) (*model.Product, error) {
+	query := `
+	SELECT id, name, "desc", price, category_id, created_at, ` + hasVariantsColumn + `, ` + primaryImageColumns + `
+	FROM products WHERE id = $1
+	`
+
+	var p model.Product
+	err := s.DB.QueryRowContext(ctx, query, id).
+		Scan(&p.ID, &p.Name, &p.Desc, &p.Price, &p.CategoryID, &p.CreatedAt, &p.HasVariants, &p.PrimaryImageURL, &p.PrimaryThumbnailURL)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
 }
 
 // LIST (with Redis cache)
-func (s *ProductServer) ListProducts(ctx context.Context, _ *emptypb.Empty) (*pb.ListProductsResponse, error) {
+func (s *ProductServer) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	// hasil filter kategori tidak di-cache
+	if req.CategoryId != 0 {
+		return s.listProductsByCategory(ctx, req.CategoryId, req.IncludeDescendants)
+	}
+
 	cacheKey := "products:all"
 
 	// 1. Try Redis
@@ -120,12 +155,47 @@ func (s *ProductServer) ListProducts(ctx context.Context, _ *emptypb.Empty) (*pb
 	}
 
 	// 2. Query DB
+	products, err := s.queryProducts(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// save to Redis
+	b, _ := json.Marshal(products)
+	s.Redis.Set(ctx, cacheKey, b, 5*time.Minute)
+
+	resp := &pb.ListProductsResponse{}
+	for _, p := range products {
+		resp.Products = append(resp.Products, toProtoProduct(p))
+	}
+	return resp, nil
+}
+
+func (s *ProductServer) listProductsByCategory(ctx context.Context, categoryID uint32, includeDescendants bool) (*pb.ListProductsResponse, error) {
+	where := `WHERE category_id = $1`
+	if includeDescendants {
+		where = `WHERE category_id IN (` + categoryDescendantsQuery + `)`
+	}
+
+	products, err := s.queryProducts(ctx, where, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListProductsResponse{}
+	for _, p := range products {
+		resp.Products = append(resp.Products, toProtoProduct(p))
+	}
+	return resp, nil
+}
+
+func (s *ProductServer) queryProducts(ctx context.Context, where string, args ...interface{}) ([]*model.Product, error) {
 	query := `
-	SELECT id, name, "desc", price, category_id, created_at
+	SELECT id, name, "desc", price, category_id, created_at, ` + hasVariantsColumn + `, ` + primaryImageColumns + `
 	FROM products
-	`
+	` + where
 
-	rows, err := s.DB.QueryContext(ctx, query)
+	rows, err := s.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "query error: %v", err)
 	}
@@ -134,21 +204,12 @@ func (s *ProductServer) ListProducts(ctx context.Context, _ *emptypb.Empty) (*pb
 	var products []*model.Product
 	for rows.Next() {
 		var p model.Product
-		if err := rows.Scan(&p.ID, &p.Name, &p.Desc, &p.Price, &p.CategoryID, &p.CreatedAt); err != nil {
+		if err := rows.Scan(&p.ID, &p.Name, &p.Desc, &p.Price, &p.CategoryID, &p.CreatedAt, &p.HasVariants, &p.PrimaryImageURL, &p.PrimaryThumbnailURL); err != nil {
 			return nil, status.Errorf(codes.Internal, "scan error: %v", err)
 		}
 		products = append(products, &p)
 	}
-
-	// save to Redis
-	b, _ := json.Marshal(products)
-	s.Redis.Set(ctx, cacheKey, b, 5*time.Minute)
-
-	resp := &pb.ListProductsResponse{}
-	for _, p := range products {
-		resp.Products = append(resp.Products, toProtoProduct(p))
-	}
-	return resp, nil
+	return products, nil
 }
 
 // UPDATE
@@ -157,14 +218,14 @@ func (s *ProductServer) UpdateProduct(ctx context.Context, req *pb.UpdateProduct
 	UPDATE products 
 	SET name=$1, "desc"=$2, price=$3, category_id=$4
 	WHERE id=$5
-	RETURNING id, name, "desc", price, category_id, created_at
+	RETURNING id, name, "desc", price, category_id, created_at, ` + hasVariantsColumn + `, ` + primaryImageColumns + `
 	`
 
 	var p model.Product
 	err := s.DB.QueryRowContext(
 		ctx, query,
 		req.Name, req.Desc, req.Price, req.CategoryId, req.Id,
-	).Scan(&p.ID, &p.Name, &p.Desc, &p.Price, &p.CategoryID, &p.CreatedAt)
+	).Scan(&p.ID, &p.Name, &p.Desc, &p.Price, &p.CategoryID, &p.CreatedAt, &p.HasVariants, &p.PrimaryImageURL, &p.PrimaryThumbnailURL)
 
 	if err == sql.ErrNoRows {
 		return nil, status.Errorf(codes.NotFound, "product not found")
@@ -180,13 +241,7 @@ func (s *ProductServer) UpdateProduct(ctx context.Context, req *pb.UpdateProduct
 	// publish event
 	event := map[string]interface{}{
 		"event_type": "product_updated",
-		"data": map[string]interface{}{
-			"id":          p.ID,
-			"name":        p.Name,
-			"desc":        p.Desc,
-			"price":       p.Price,
-			"category_id": p.CategoryID,
-		},
+		"data":       s.productEventData(ctx, &p),
 	}
 	s.Producer.PublishProductUpdatedEvent(event)
 
@@ -195,10 +250,16 @@ func (s *ProductServer) UpdateProduct(ctx context.Context, req *pb.UpdateProduct
 
 // DELETE
 func (s *ProductServer) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
 	// 1. Check existing product
 	var categoryID uint32
-	err := s.DB.QueryRowContext(
-		ctx, `SELECT category_id FROM products WHERE id=$1`, req.Id,
+	err = tx.QueryRowContext(
+		ctx, `SELECT category_id FROM products WHERE id=$1 FOR UPDATE`, req.Id,
 	).Scan(&categoryID)
 
 	if err == sql.ErrNoRows {
@@ -208,17 +269,29 @@ func (s *ProductServer) DeleteProduct(ctx context.Context, req *pb.DeleteProduct
 		return nil, status.Errorf(codes.Internal, "query error: %v", err)
 	}
 
-	// 2. Delete
-	_, err = s.DB.ExecContext(ctx, `DELETE FROM products WHERE id=$1`, req.Id)
+	// 2. Delete (gambar, variant & option type dulu) dalam satu transaksi
+	keys, err := deleteImages(ctx, tx, `product_id=$1`, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete error: %v", err)
+	}
+	variantIDs, err := deleteProductVariants(ctx, tx, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "delete error: %v", err)
 	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM products WHERE id=$1`, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit error: %v", err)
+	}
 
-	// 3. Clear cache
+	// 3. Clear cache & file gambar (setelah commit)
 	s.Redis.Del(ctx, "products:all")
 	s.Redis.Del(ctx, fmt.Sprintf("products:category:%d", categoryID))
+	s.removeObjects(keys...)
 
 	// 4. Publish event
+	s.publishVariantsDeleted(req.Id, variantIDs...)
 	event := map[string]interface{}{
 		"event_type": "product_deleted",
 		"data": map[string]interface{}{
@@ -233,30 +306,36 @@ func (s *ProductServer) DeleteProduct(ctx context.Context, req *pb.DeleteProduct
 // ====================== CATEGORY ======================
 
 func (s *ProductServer) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CategoryResponse, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if err := checkCategoryParent(ctx, s.DB, 0, req.ParentId); err != nil {
+		return nil, err
+	}
+	slug, err := categorySlug(ctx, s.DB, 0, req.Slug, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
-	INSERT INTO categories (name) VALUES ($1)
-	RETURNING id, name
-	`
+	INSERT INTO categories (name, parent_id, slug, sort_order) VALUES ($1, NULLIF($2, 0), $3, $4)
+	RETURNING ` + categoryColumns
 
 	var c model.Category
-	err := s.DB.QueryRowContext(ctx, query, req.Name).
-		Scan(&c.ID, &c.Name)
+	err = scanCategory(s.DB.QueryRowContext(ctx, query, req.Name, int64(req.ParentId), slug, req.SortOrder), &c)
 
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to insert: %v", err)
 	}
 
 	return &pb.CategoryResponse{
-		Category: &pb.Category{
-			Id:   uint32(c.ID),
-			Name: c.Name,
-		},
+		Category: toProtoCategory(&c),
 	}, nil
 }
 
 func (s *ProductServer) ListCategories(ctx context.Context, _ *emptypb.Empty) (*pb.ListCategoriesResponse, error) {
 	query := `
-	SELECT id, name FROM categories
+	SELECT ` + categoryColumns + ` FROM categories ORDER BY sort_order, name, id
 	`
 
 	rows, err := s.DB.QueryContext(ctx, query)
@@ -268,43 +347,86 @@ func (s *ProductServer) ListCategories(ctx context.Context, _ *emptypb.Empty) (*
 	resp := &pb.ListCategoriesResponse{}
 	for rows.Next() {
 		var c model.Category
-		if err := rows.Scan(&c.ID, &c.Name); err != nil {
+		if err := scanCategory(rows, &c); err != nil {
 			return nil, status.Errorf(codes.Internal, "scan error: %v", err)
 		}
-		resp.Categories = append(resp.Categories, &pb.Category{
-			Id:   uint32(c.ID),
-			Name: c.Name,
-		})
+		resp.Categories = append(resp.Categories, toProtoCategory(&c))
 	}
 
 	return resp, nil
 }
 func (s *ProductServer) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.CategoryResponse, error) {
-	query := `
-	UPDATE categories 
-	SET name = $1
-	WHERE id = $2
-	RETURNING id, name
-	`
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
 
-	var c model.Category
-	err := s.DB.QueryRowContext(
-		ctx, query,
-		req.Name, req.Id,
-	).Scan(&c.ID, &c.Name)
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
 
+	// pemindahan kategori dikunci supaya dua pemindahan bersamaan tidak membentuk siklus
+	if req.ParentId != nil {
+		if _, err := tx.ExecContext(ctx, `LOCK TABLE categories IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+			return nil, status.Errorf(codes.Internal, "lock error: %v", err)
+		}
+	}
+
+	var current model.Category
+	err = scanCategory(tx.QueryRowContext(ctx,
+		`SELECT `+categoryColumns+` FROM categories WHERE id=$1 FOR UPDATE`, req.Id), &current)
 	if err == sql.ErrNoRows {
 		return nil, status.Errorf(codes.NotFound, "category not found")
 	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	var oldParent uint32
+	if current.ParentID != nil {
+		oldParent = uint32(*current.ParentID)
+	}
+	parentID, sortOrder, slug := oldParent, int32(current.SortOrder), current.Slug
+	if req.ParentId != nil {
+		parentID = *req.ParentId
+		if err := checkCategoryParent(ctx, tx, req.Id, parentID); err != nil {
+			return nil, err
+		}
+	}
+	if req.SortOrder != nil {
+		sortOrder = *req.SortOrder
+	}
+	if req.Slug != "" {
+		if slug, err = categorySlug(ctx, tx, req.Id, req.Slug, req.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+	UPDATE categories 
+	SET name = $1, parent_id = NULLIF($2, 0), slug = $3, sort_order = $4
+	WHERE id = $5
+	RETURNING ` + categoryColumns
+
+	var c model.Category
+	err = scanCategory(tx.QueryRowContext(
+		ctx, query,
+		req.Name, int64(parentID), slug, sortOrder, req.Id,
+	), &c)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "update error: %v", err)
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit error: %v", err)
+	}
+
+	if parentID != oldParent {
+		s.reindexCategoryProducts(ctx, req.Id)
+	}
 
 	return &pb.CategoryResponse{
-		Category: &pb.Category{
-			Id:   uint32(c.ID),
-			Name: c.Name,
-		},
+		Category: toProtoCategory(&c),
 	}, nil
 }
 func (s *ProductServer) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
@@ -322,7 +444,21 @@ func (s *ProductServer) DeleteCategory(ctx context.Context, req *pb.DeleteCatego
 		return nil, status.Errorf(codes.NotFound, "category not found")
 	}
 
-	// 2. Check if category used by products
+	// 2. Check if category still has children
+	var hasChildren bool
+	err = s.DB.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM categories WHERE parent_id=$1)`,
+		req.Id,
+	).Scan(&hasChildren)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if hasChildren {
+		return nil, status.Errorf(codes.FailedPrecondition, "category has child categories")
+	}
+
+	// 3. Check if category used by products
 	var used bool
 	err = s.DB.QueryRowContext(ctx,
 		`SELECT EXISTS(SELECT 1 FROM products WHERE category_id=$1)`,
@@ -336,7 +472,7 @@ func (s *ProductServer) DeleteCategory(ctx context.Context, req *pb.DeleteCatego
 		return nil, status.Errorf(codes.FailedPrecondition, "category in use by products")
 	}
 
-	// 3. Delete
+	// 4. Delete
 	_, err = s.DB.ExecContext(ctx,
 		`DELETE FROM categories WHERE id=$1`,
 		req.Id,
@@ -350,7 +486,6 @@ func (s *ProductServer) DeleteCategory(ctx context.Context, req *pb.DeleteCatego
 	}, nil
 }
 
-
 // ====================== STOCK ======================
 
 func (s *ProductServer) UpdateStock(ctx context.Context, req *pb.UpdateStockRequest) (*pb.StockResponse, error) {
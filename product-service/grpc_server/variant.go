@@ -0,0 +1,490 @@
+package grpc_server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"product-service/model"
+	pb "product-service/proto/product"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ====================== OPTION TYPE ======================
+
+func (s *ProductServer) CreateOptionType(ctx context.Context, req *pb.CreateOptionTypeRequest) (*pb.OptionTypeResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	values := normalizeValues(req.Values)
+	if len(values) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "values is required")
+	}
+
+	if err := s.checkProductExists(ctx, req.ProductId); err != nil {
+		return nil, err
+	}
+	// variant lama tidak punya nilai untuk option baru, jadi option type dikunci setelah ada variant
+	if err := s.checkNoVariants(ctx, req.ProductId); err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	err := s.DB.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM option_types WHERE product_id=$1 AND name=$2)`,
+		req.ProductId, name,
+	).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "option type %q already exists", name)
+	}
+
+	valuesJSON, _ := json.Marshal(values)
+
+	var o model.OptionType
+	err = s.DB.QueryRowContext(ctx, `
+	INSERT INTO option_types (product_id, name, "values")
+	VALUES ($1, $2, $3::jsonb)
+	RETURNING id, product_id, name, "values"
+	`, req.ProductId, name, string(valuesJSON)).Scan(&o.ID, &o.ProductID, &o.Name, &o.Values)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to insert: %v", err)
+	}
+
+	return &pb.OptionTypeResponse{OptionType: toProtoOptionType(&o)}, nil
+}
+
+func (s *ProductServer) ListOptionTypes(ctx context.Context, req *pb.ListOptionTypesRequest) (*pb.ListOptionTypesResponse, error) {
+	optionTypes, err := s.loadOptionTypes(ctx, req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListOptionTypesResponse{}
+	for i := range optionTypes {
+		resp.OptionTypes = append(resp.OptionTypes, toProtoOptionType(&optionTypes[i]))
+	}
+	return resp, nil
+}
+
+func (s *ProductServer) DeleteOptionType(ctx context.Context, req *pb.DeleteOptionTypeRequest) (*pb.DeleteOptionTypeResponse, error) {
+	var productID uint32
+	err := s.DB.QueryRowContext(ctx, `SELECT product_id FROM option_types WHERE id=$1`, req.Id).Scan(&productID)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "option type not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	if err := s.checkNoVariants(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.DB.ExecContext(ctx, `DELETE FROM option_types WHERE id=$1`, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete error: %v", err)
+	}
+
+	return &pb.DeleteOptionTypeResponse{Message: "Option type deleted successfully"}, nil
+}
+
+// ====================== VARIANT ======================
+
+const variantColumns = `id, product_id, sku, barcode, price, stock, options, created_at, updated_at`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanVariant(row rowScanner, v *model.ProductVariant) error {
+	return row.Scan(&v.ID, &v.ProductID, &v.SKU, &v.Barcode, &v.Price, &v.Stock, &v.Options, &v.CreatedAt, &v.UpdatedAt)
+}
+
+func (s *ProductServer) CreateVariant(ctx context.Context, req *pb.CreateVariantRequest) (*pb.VariantResponse, error) {
+	if err := s.checkProductExists(ctx, req.ProductId); err != nil {
+		return nil, err
+	}
+
+	in, err := s.variantInput(ctx, req.ProductId, 0, req.Sku, req.Barcode, req.Stock, req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	var v model.ProductVariant
+	err = scanVariant(s.DB.QueryRowContext(ctx, `
+	INSERT INTO product_variants (product_id, sku, barcode, price, stock, options, option_key, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6::jsonb, $7, NOW(), NOW())
+	RETURNING `+variantColumns,
+		req.ProductId, in.sku, in.barcode, req.Price, req.Stock, in.options, in.optionKey,
+	), &v)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to insert: %v", err)
+	}
+
+	// has_variants produk ikut berubah
+	s.Redis.Del(ctx, "products:all")
+
+	s.Producer.PublishVariantCreatedEvent(map[string]interface{}{
+		"event_type": "product_variant_created",
+		"data":       variantEventData(&v),
+	})
+
+	return &pb.VariantResponse{Variant: toProtoVariant(&v)}, nil
+}
+
+func (s *ProductServer) GetVariant(ctx context.Context, req *pb.GetVariantRequest) (*pb.VariantResponse, error) {
+	var v model.ProductVariant
+	err := scanVariant(s.DB.QueryRowContext(ctx,
+		`SELECT `+variantColumns+` FROM product_variants WHERE id=$1`, req.Id), &v)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "variant not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	return &pb.VariantResponse{Variant: toProtoVariant(&v)}, nil
+}
+
+func (s *ProductServer) ListVariants(ctx context.Context, req *pb.ListVariantsRequest) (*pb.ListVariantsResponse, error) {
+	rows, err := s.DB.QueryContext(ctx,
+		`SELECT `+variantColumns+` FROM product_variants WHERE product_id=$1 ORDER BY id`, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &pb.ListVariantsResponse{}
+	for rows.Next() {
+		var v model.ProductVariant
+		if err := scanVariant(rows, &v); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan error: %v", err)
+		}
+		resp.Variants = append(resp.Variants, toProtoVariant(&v))
+	}
+
+	return resp, nil
+}
+
+func (s *ProductServer) UpdateVariant(ctx context.Context, req *pb.UpdateVariantRequest) (*pb.VariantResponse, error) {
+	var productID uint32
+	err := s.DB.QueryRowContext(ctx, `SELECT product_id FROM product_variants WHERE id=$1`, req.Id).Scan(&productID)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "variant not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	in, err := s.variantInput(ctx, productID, req.Id, req.Sku, req.Barcode, req.Stock, req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	var v model.ProductVariant
+	err = scanVariant(s.DB.QueryRowContext(ctx, `
+	UPDATE product_variants
+	SET sku=$1, barcode=$2, price=$3, stock=$4, options=$5::jsonb, option_key=$6, updated_at=NOW()
+	WHERE id=$7
+	RETURNING `+variantColumns,
+		in.sku, in.barcode, req.Price, req.Stock, in.options, in.optionKey, req.Id,
+	), &v)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "variant not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update error: %v", err)
+	}
+
+	// harga / stok variant ikut tampil di daftar produk
+	s.Redis.Del(ctx, "products:all")
+
+	s.Producer.PublishVariantUpdatedEvent(map[string]interface{}{
+		"event_type": "product_variant_updated",
+		"data":       variantEventData(&v),
+	})
+
+	return &pb.VariantResponse{Variant: toProtoVariant(&v)}, nil
+}
+
+func (s *ProductServer) DeleteVariant(ctx context.Context, req *pb.DeleteVariantRequest) (*pb.DeleteVariantResponse, error) {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var productID uint32
+	err = tx.QueryRowContext(ctx,
+		`SELECT product_id FROM product_variants WHERE id=$1 FOR UPDATE`, req.Id).Scan(&productID)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "variant not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+
+	// galeri variant ikut dihapus
+	keys, err := deleteImages(ctx, tx, `variant_id=$1`, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete error: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_variants WHERE id=$1`, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit error: %v", err)
+	}
+
+	s.removeObjects(keys...)
+	s.Redis.Del(ctx, "products:all")
+	s.publishVariantsDeleted(productID, req.Id)
+
+	return &pb.DeleteVariantResponse{Message: "Variant deleted successfully"}, nil
+}
+
+// ====================== HELPER ======================
+
+type variantFields struct {
+	sku       string
+	barcode   *string
+	options   string
+	optionKey string
+}
+
+// variantInput memvalidasi input create / update variant. excludeID = id variant yang sedang
+// diubah, supaya tidak bentrok dengan dirinya sendiri saat cek duplikat.
+func (s *ProductServer) variantInput(ctx context.Context, productID, excludeID uint32, sku, barcode string, stock int32, options map[string]string) (*variantFields, error) {
+	in := &variantFields{sku: strings.TrimSpace(sku)}
+	if in.sku == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "sku is required")
+	}
+	if stock < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "stock must not be negative")
+	}
+	// barcode kosong disimpan NULL supaya tidak bentrok di unique index
+	if b := strings.TrimSpace(barcode); b != "" {
+		in.barcode = &b
+	}
+
+	optionTypes, err := s.loadOptionTypes(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if in.optionKey, err = optionKey(optionTypes, options); err != nil {
+		return nil, err
+	}
+	if options == nil {
+		options = map[string]string{}
+	}
+	b, _ := json.Marshal(options)
+	in.options = string(b)
+
+	var dup string
+	err = s.DB.QueryRowContext(ctx, `
+	SELECT CASE
+		WHEN sku=$1 THEN 'sku'
+		WHEN barcode IS NOT NULL AND barcode=$2 THEN 'barcode'
+		ELSE 'options' END
+	FROM product_variants
+	WHERE id<>$5 AND (sku=$1 OR (barcode IS NOT NULL AND barcode=$2) OR (product_id=$3 AND option_key=$4))
+	LIMIT 1
+	`, in.sku, in.barcode, productID, in.optionKey, excludeID).Scan(&dup)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err == nil {
+		if dup == "options" {
+			return nil, status.Errorf(codes.AlreadyExists, "a variant with the same options already exists")
+		}
+		return nil, status.Errorf(codes.AlreadyExists, "%s already used by another variant", dup)
+	}
+
+	return in, nil
+}
+
+// optionKey memastikan options berisi tepat satu nilai yang valid untuk setiap option type
+// produk, lalu mengembalikan bentuk kanoniknya.
+func optionKey(optionTypes []model.OptionType, options map[string]string) (string, error) {
+	if len(options) != len(optionTypes) {
+		names := make([]string, 0, len(optionTypes))
+		for _, o := range optionTypes {
+			names = append(names, o.Name)
+		}
+		return "", status.Errorf(codes.InvalidArgument, "options must set exactly: [%s]", strings.Join(names, ", "))
+	}
+
+	parts := make([]string, 0, len(optionTypes))
+	for _, o := range optionTypes {
+		value, ok := options[o.Name]
+		if !ok {
+			return "", status.Errorf(codes.InvalidArgument, "missing option %q", o.Name)
+		}
+		var allowed []string
+		json.Unmarshal(o.Values, &allowed)
+		valid := false
+		for _, a := range allowed {
+			if a == value {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return "", status.Errorf(codes.InvalidArgument, "invalid value %q for option %q", value, o.Name)
+		}
+		parts = append(parts, strings.ToLower(o.Name)+"="+strings.ToLower(value))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ";"), nil
+}
+
+func (s *ProductServer) loadOptionTypes(ctx context.Context, productID uint32) ([]model.OptionType, error) {
+	rows, err := s.DB.QueryContext(ctx,
+		`SELECT id, product_id, name, "values" FROM option_types WHERE product_id=$1 ORDER BY id`, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query error: %v", err)
+	}
+	defer rows.Close()
+
+	var out []model.OptionType
+	for rows.Next() {
+		var o model.OptionType
+		if err := rows.Scan(&o.ID, &o.ProductID, &o.Name, &o.Values); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan error: %v", err)
+		}
+		out = append(out, o)
+	}
+	return out, nil
+}
+
+func (s *ProductServer) checkProductExists(ctx context.Context, productID uint32) error {
+	var exists bool
+	err := s.DB.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM products WHERE id=$1)`, productID).Scan(&exists)
+	if err != nil {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !exists {
+		return status.Errorf(codes.NotFound, "product not found")
+	}
+	return nil
+}
+
+func (s *ProductServer) checkNoVariants(ctx context.Context, productID uint32) error {
+	var used bool
+	err := s.DB.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM product_variants WHERE product_id=$1)`, productID).Scan(&used)
+	if err != nil {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if used {
+		return status.Errorf(codes.FailedPrecondition, "product already has variants, delete them first")
+	}
+	return nil
+}
+
+func normalizeValues(values []string) []string {
+	seen := map[string]bool{}
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" || seen[strings.ToLower(v)] {
+			continue
+		}
+		seen[strings.ToLower(v)] = true
+		out = append(out, v)
+	}
+	return out
+}
+
+func toProtoOptionType(o *model.OptionType) *pb.OptionType {
+	var values []string
+	json.Unmarshal(o.Values, &values)
+	return &pb.OptionType{
+		Id:        uint32(o.ID),
+		ProductId: uint32(o.ProductID),
+		Name:      o.Name,
+		Values:    values,
+	}
+}
+
+func toProtoVariant(v *model.ProductVariant) *pb.Variant {
+	var options map[string]string
+	json.Unmarshal(v.Options, &options)
+	out := &pb.Variant{
+		Id:        uint32(v.ID),
+		ProductId: uint32(v.ProductID),
+		Sku:       v.SKU,
+		Price:     uint32(v.Price),
+		Stock:     int32(v.Stock),
+		Options:   options,
+		CreatedAt: v.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: v.UpdatedAt.Format(time.RFC3339),
+	}
+	if v.Barcode != nil {
+		out.Barcode = *v.Barcode
+	}
+	return out
+}
+
+func variantEventData(v *model.ProductVariant) map[string]interface{} {
+	data := map[string]interface{}{
+		"id":         v.ID,
+		"product_id": v.ProductID,
+		"sku":        v.SKU,
+		"price":      v.Price,
+		"stock":      v.Stock,
+		"options":    v.Options,
+	}
+	if v.Barcode != nil {
+		data["barcode"] = *v.Barcode
+	}
+	return data
+}
+
+// deleteProductVariants menghapus option type dan variant di dalam tx saat produknya dihapus,
+// lalu mengembalikan id variant untuk event product_variant_deleted setelah commit.
+func deleteProductVariants(ctx context.Context, tx *sql.Tx, productID uint32) ([]uint32, error) {
+	rows, err := tx.QueryContext(ctx, `DELETE FROM product_variants WHERE product_id=$1 RETURNING id`, productID)
+	if err != nil {
+		return nil, fmt.Errorf("delete variants: %w", err)
+	}
+	var ids []uint32
+	for rows.Next() {
+		var id uint32
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("delete variants: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("delete variants: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM option_types WHERE product_id=$1`, productID); err != nil {
+		return nil, fmt.Errorf("delete option types: %w", err)
+	}
+	return ids, nil
+}
+
+func (s *ProductServer) publishVariantsDeleted(productID uint32, ids ...uint32) {
+	for _, id := range ids {
+		s.Producer.PublishVariantDeletedEvent(map[string]interface{}{
+			"event_type": "product_variant_deleted",
+			"data": map[string]interface{}{
+				"id":         id,
+				"product_id": productID,
+			},
+		})
+	}
+}
@@ -66,6 +66,19 @@ func (p *Producer) PublishCategoryDeletedEvent(event map[string]interface{}) {
 func (p *Producer) PublishStockUpdatedEvent(event map[string]interface{}) {
 	p.publish("stock.updated", event)
 }
+
+func (p *Producer) PublishVariantCreatedEvent(event map[string]interface{}) {
+	p.publish("product.variant.created", event)
+}
+
+func (p *Producer) PublishVariantUpdatedEvent(event map[string]interface{}) {
+	p.publish("product.variant.updated", event)
+}
+
+func (p *Producer) PublishVariantDeletedEvent(event map[string]interface{}) {
+	p.publish("product.variant.deleted", event)
+}
+
 func (p *Producer) publish(topic string, event interface{}) {
 	data, err := json.Marshal(event)
 	if err != nil {
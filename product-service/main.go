@@ -8,11 +8,15 @@ import (
 	"product-service/model"
 	pb "product-service/proto/product"
 	"product-service/routes"
+	"product-service/storage"
+	"product-service/svcauth"
 
+	"context"
 	"database/sql"
 	"log"
 	"net"
 	"os"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/logger"
@@ -44,7 +48,7 @@ func initDB() {
 	}
 
 	// automigrate models
-	if err := DB.AutoMigrate(&model.Product{}, &model.Category{}, &model.Stock{}); err != nil {
+	if err := DB.AutoMigrate(&model.Product{}, &model.Category{}, &model.Stock{}, &model.OptionType{}, &model.ProductVariant{}, &model.ProductImage{}); err != nil {
 		log.Fatal(err)
 	}
 
@@ -52,18 +56,35 @@ func initDB() {
 	if err != nil {
 		log.Fatal("failed to get sql.DB:", err)
 	}
+
+	// slug kategori lama diisi dulu, baru unique index bisa dibuat
+	if err := grpc_server.BackfillCategorySlugs(context.Background(), SQLDB); err != nil {
+		log.Fatal("failed to backfill category slugs:", err)
+	}
+	if err := DB.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_slug ON categories (slug)`).Error; err != nil {
+		log.Fatal("failed to create category slug index:", err)
+	}
 }
 
 func main() {
+	svcauth.Init()
 	initDB()
 	producer := kafkax.NewProducer()
 	cache.ConnectRedis()
+	store := newStorage()
+	maxImageBytes := getEnvInt("MEDIA_MAX_BYTES", 5<<20)
 
 	// HTTP SERVER (Fiber)
 	go func() {
-		app := fiber.New()
+		// upload gambar produk lewat multipart, batasnya diatur MEDIA_MAX_BYTES
+		app := fiber.New(fiber.Config{BodyLimit: maxImageBytes + 1<<20})
 		app.Use(logger.New())
 
+		// storage local di-serve dari sini; untuk s3 URL-nya langsung ke bucket / CDN
+		if local, ok := store.(*storage.Local); ok {
+			app.Static("/media", local.Dir)
+		}
+
 		// Register product routes
 		routes.RegisterProductRoutes(app, DB, middleware.AuthMiddleware())
 
@@ -85,11 +106,19 @@ func main() {
 			Addr: redisAddr,
 		})
 
-		grpcServer := grpc.NewServer()
+		grpcServer := grpc.NewServer(
+			grpc.UnaryInterceptor(svcauth.UnaryInterceptor(svcauth.ServerPolicy)),
+			grpc.MaxRecvMsgSize(maxImageBytes+1<<20),
+		)
 		productServer := &grpc_server.ProductServer{
 			DB:       SQLDB,
 			Producer: producer,
 			Redis:    rdb,
+
+			Storage:        store,
+			MaxImageBytes:  maxImageBytes,
+			MaxImagePixels: getEnvInt("MEDIA_MAX_PIXELS", 25_000_000), // mis. 5000x5000
+			ThumbnailSize:  getEnvInt("MEDIA_THUMBNAIL_SIZE", 320),
 		}
 
 		pb.RegisterProductServiceServer(grpcServer, productServer)
@@ -103,6 +132,36 @@ func main() {
 	select {}
 }
 
+// newStorage memilih backend media dari env MEDIA_STORAGE (local | s3).
+func newStorage() storage.Storage {
+	switch getEnv("MEDIA_STORAGE", "local") {
+	case "local":
+		local, err := storage.NewLocal(getEnv("MEDIA_DIR", "./media"), getEnv("MEDIA_BASE_URL", "http://localhost:3005/media"))
+		if err != nil {
+			log.Fatal("failed to prepare media dir:", err)
+		}
+		return local
+	case "s3":
+		bucket := os.Getenv("S3_BUCKET")
+		if bucket == "" {
+			log.Fatal("S3_BUCKET is required when MEDIA_STORAGE=s3")
+		}
+		return storage.NewS3(getEnv("S3_ENDPOINT", "https://s3.amazonaws.com"), bucket, os.Getenv("S3_REGION"),
+			os.Getenv("S3_ACCESS_KEY"), os.Getenv("S3_SECRET_KEY"), os.Getenv("S3_PUBLIC_URL"))
+	default:
+		log.Fatalf("unknown MEDIA_STORAGE %q", getEnv("MEDIA_STORAGE", ""))
+		return nil
+	}
+}
+
+func getEnvInt(k string, d int) int {
+	v, err := strconv.Atoi(os.Getenv(k))
+	if err != nil || v <= 0 {
+		return d
+	}
+	return v
+}
+
 func getEnv(k, d string) string {
 	if v := os.Getenv(k); v != "" {
 		return v
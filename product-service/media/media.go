@@ -0,0 +1,121 @@
+// Package media memvalidasi gambar produk yang di-upload dan membuat thumbnail-nya.
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"net/http"
+
+	_ "image/gif"
+	_ "image/png"
+)
+
+var ErrUnsupportedType = errors.New("media: only jpeg, png and gif images are supported")
+
+// ekstensi file untuk content type yang diterima
+var extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// Image adalah hasil Process: file asli plus thumbnail JPEG-nya.
+type Image struct {
+	ContentType string
+	Ext         string
+	Width       int
+	Height      int
+	Thumbnail   []byte
+}
+
+// Process mengecek isi file (bukan nama / header dari client), lalu membuat thumbnail
+// dengan sisi terpanjang thumbSize piksel. Gambar di atas maxPixels (lebar x tinggi) ditolak
+// sebelum di-decode, karena file kecil yang sangat terkompresi bisa jadi bitmap berukuran GB.
+func Process(data []byte, maxBytes int, maxPixels int, thumbSize int) (*Image, error) {
+	if len(data) == 0 {
+		return nil, errors.New("media: empty file")
+	}
+	if maxBytes > 0 && len(data) > maxBytes {
+		return nil, fmt.Errorf("media: file larger than %d bytes", maxBytes)
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := extensions[contentType]
+	if !ok {
+		return nil, ErrUnsupportedType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("media: invalid image: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, errors.New("media: invalid image dimensions")
+	}
+	if maxPixels > 0 && int64(cfg.Width)*int64(cfg.Height) > int64(maxPixels) {
+		return nil, fmt.Errorf("media: image %dx%d exceeds %d pixels", cfg.Width, cfg.Height, maxPixels)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("media: invalid image: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, Thumbnail(img, thumbSize), &jpeg.Options{Quality: 80}); err != nil {
+		return nil, fmt.Errorf("media: thumbnail: %w", err)
+	}
+
+	b := img.Bounds()
+	return &Image{
+		ContentType: contentType,
+		Ext:         ext,
+		Width:       b.Dx(),
+		Height:      b.Dy(),
+		Thumbnail:   buf.Bytes(),
+	}, nil
+}
+
+// Thumbnail memperkecil img (tidak pernah memperbesar) dengan box filter, rasio dipertahankan.
+// Latar transparan dijadikan putih karena thumbnail disimpan sebagai JPEG.
+func Thumbnail(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > h && w > size {
+		w, h = size, max(1, h*size/w)
+	} else if h >= w && h > size {
+		w, h = max(1, w*size/h), size
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		sy0 := b.Min.Y + y*b.Dy()/h
+		sy1 := max(sy0+1, b.Min.Y+(y+1)*b.Dy()/h)
+		for x := 0; x < w; x++ {
+			sx0 := b.Min.X + x*b.Dx()/w
+			sx1 := max(sx0+1, b.Min.X+(x+1)*b.Dx()/w)
+
+			var r, g, bl, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					cr, cg, cb, ca := img.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			// warna premultiplied alpha: tambahkan putih sebanyak bagian yang transparan
+			white := (0xffff*n - a)
+			dst.Set(x, y, color.RGBA64{
+				R: uint16((r + white) / n),
+				G: uint16((g + white) / n),
+				B: uint16((bl + white) / n),
+				A: 0xffff,
+			})
+		}
+	}
+	return dst
+}
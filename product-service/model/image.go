@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// ProductImage adalah satu gambar di galeri produk (VariantID 0) atau galeri variant.
+// Position 0 di galeri produk adalah gambar utama.
+type ProductImage struct {
+	ID        uint `gorm:"primaryKey" json:"id"`
+	ProductID uint `gorm:"not null;index:idx_product_images_gallery" json:"product_id"`
+	VariantID uint `gorm:"not null;default:0;index:idx_product_images_gallery" json:"variant_id"`
+	Position  int  `gorm:"not null;default:0" json:"position"`
+
+	StorageKey   string `gorm:"not null" json:"-"`
+	ThumbnailKey string `gorm:"not null" json:"-"`
+	URL          string `gorm:"not null" json:"url"`
+	ThumbnailURL string `gorm:"not null" json:"thumbnail_url"`
+
+	ContentType string    `json:"content_type"`
+	Width       int       `json:"width"`
+	Height      int       `json:"height"`
+	SizeBytes   int       `json:"size_bytes"`
+	AltText     string    `json:"alt_text"`
+	CreatedAt   time.Time `json:"created_at"`
+}
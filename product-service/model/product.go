@@ -11,11 +11,20 @@ type Product struct {
     Price      uint      `json:"price"`
     CategoryID uint      `json:"category_id"`
     CreatedAt  time.Time `json:"created_at"`
+
+    // dihitung dari product_variants, bukan kolom
+    HasVariants bool `gorm:"-" json:"has_variants"`
+    // gambar pertama galeri produk, kosong kalau belum ada
+    PrimaryImageURL     string `gorm:"-" json:"primary_image_url"`
+    PrimaryThumbnailURL string `gorm:"-" json:"primary_thumbnail_url"`
 }
 
 type Category struct {
-	ID   uint   `gorm:"primaryKey" json:"id"`
-	Name string `json:"name"`
+	ID        uint   `gorm:"primaryKey" json:"id"`
+	Name      string `json:"name"`
+	ParentID  *uint  `gorm:"index" json:"parent_id"` // nil = root
+	Slug      string `gorm:"not null;default:''" json:"slug"`
+	SortOrder int    `gorm:"not null;default:0" json:"sort_order"`
 }
 
 type Stock struct {
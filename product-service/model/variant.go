@@ -0,0 +1,30 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OptionType adalah dimensi variant sebuah produk, mis. "size" dengan values ["S","M","L"].
+type OptionType struct {
+	ID        uint            `gorm:"primaryKey" json:"id"`
+	ProductID uint            `gorm:"not null;uniqueIndex:idx_option_types_product_name" json:"product_id"`
+	Name      string          `gorm:"not null;uniqueIndex:idx_option_types_product_name" json:"name"`
+	Values    json.RawMessage `gorm:"type:jsonb;not null" json:"values"`
+}
+
+// ProductVariant adalah SKU yang benar-benar dijual: satu kombinasi option dengan harga,
+// stok dan barcode sendiri.
+type ProductVariant struct {
+	ID        uint            `gorm:"primaryKey" json:"id"`
+	ProductID uint            `gorm:"not null;index;uniqueIndex:idx_variants_product_options" json:"product_id"`
+	SKU       string          `gorm:"not null;uniqueIndex" json:"sku"`
+	Barcode   *string         `gorm:"uniqueIndex" json:"barcode,omitempty"`
+	Price     uint            `json:"price"`
+	Stock     int             `gorm:"not null;default:0" json:"stock"`
+	Options   json.RawMessage `gorm:"type:jsonb;not null" json:"options"`
+	// kombinasi option dalam bentuk kanonik ("color=red;size=m") supaya tidak ada dua variant yang sama
+	OptionKey string    `gorm:"not null;uniqueIndex:idx_variants_product_options" json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
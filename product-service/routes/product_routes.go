@@ -17,20 +17,39 @@ func RegisterProductRoutes(app *fiber.App,db *gorm.DB, authMiddleware fiber.Hand
 
 	//products
 	p.Get("/", pc.ListProducts)
-	p.Post("/", authMiddleware,middleware.RoleRequired("admin"), pc.CreateProduct)
+	p.Post("/", authMiddleware,middleware.RequirePermission("product:write"), pc.CreateProduct)
 	p.Get("/:id", pc.GetProduct)
-	p.Put("/:id", authMiddleware,middleware.RoleRequired("admin"), pc.UpdateProduct)
-	p.Delete("/:id",authMiddleware,middleware.RoleRequired("admin"), pc.DeleteProduct)
+	p.Put("/:id", authMiddleware,middleware.RequirePermission("product:write"), pc.UpdateProduct)
+	p.Delete("/:id",authMiddleware,middleware.RequirePermission("product:write"), pc.DeleteProduct)
+
+	//option types & variants (SKU)
+	p.Get("/:id/options", pc.ListOptionTypes)
+	p.Post("/:id/options", authMiddleware, middleware.RequirePermission("product:write"), pc.CreateOptionType)
+	p.Delete("/:id/options/:option_id", authMiddleware, middleware.RequirePermission("product:write"), pc.DeleteOptionType)
+	p.Get("/:id/variants", pc.ListVariants)
+	p.Post("/:id/variants", authMiddleware, middleware.RequirePermission("product:write"), pc.CreateVariant)
+	p.Get("/variants/:variant_id", pc.GetVariant)
+	p.Put("/variants/:variant_id", authMiddleware, middleware.RequirePermission("product:write"), pc.UpdateVariant)
+	p.Delete("/variants/:variant_id", authMiddleware, middleware.RequirePermission("product:write"), pc.DeleteVariant)
+
+	//media
+	p.Get("/:id/images", pc.ListImages)
+	p.Post("/:id/images", authMiddleware, middleware.RequirePermission("product:write"), pc.UploadImage)
+	p.Put("/:id/images/order", authMiddleware, middleware.RequirePermission("product:write"), pc.ReorderImages)
+	p.Delete("/images/:image_id", authMiddleware, middleware.RequirePermission("product:write"), pc.DeleteImage)
 
 	//categories
 	category := p.Group("/category")
-	category.Post("/", authMiddleware,middleware.RoleRequired("admin"), pc.CreateCategory)
-	category.Put("/:id", pc.UpdateCategory)
-	category.Delete("/:id", pc.DeleteCategory)
+	category.Post("/", authMiddleware,middleware.RequirePermission("category:write"), pc.CreateCategory)
+	category.Put("/:id", authMiddleware, middleware.RequirePermission("category:write"), pc.UpdateCategory)
+	category.Delete("/:id", authMiddleware, middleware.RequirePermission("category:write"), pc.DeleteCategory)
 	category.Get("/", pc.ListCategories)
+	category.Get("/tree", pc.GetCategoryTree)
+	category.Get("/:id/subtree", pc.GetCategorySubtree)
+	category.Get("/:id/breadcrumbs", pc.GetCategoryBreadcrumbs)
 
 	//stock
 	stock := p.Group("/stock")
-	stock.Get("/:product_id", authMiddleware,middleware.RoleRequired("admin"), pc.GetStock)
-	stock.Put("/", authMiddleware, pc.UpdateStock)
+	stock.Get("/:product_id", authMiddleware,middleware.RequirePermission("stock:read"), pc.GetStock)
+	stock.Put("/", authMiddleware, middleware.RequirePermission("stock:write"), pc.UpdateStock)
 }
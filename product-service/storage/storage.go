@@ -0,0 +1,18 @@
+// Package storage menyimpan file media produk. Backend dipilih lewat env MEDIA_STORAGE.
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrInvalidKey = errors.New("storage: invalid key")
+
+// Storage adalah backend penyimpanan object. Key berbentuk path relatif,
+// mis. "products/12/3f9a.jpg".
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Delete(ctx context.Context, key string) error
+	// URL publik untuk key, dipakai client untuk menampilkan gambar
+	URL(key string) string
+}
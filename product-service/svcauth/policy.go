@@ -0,0 +1,14 @@
+package svcauth
+
+// ServiceName dipakai sebagai identitas service ini di service token.
+const ServiceName = "product-service"
+
+// ServerPolicy adalah allowlist caller untuk RPC yang di-serve service ini.
+var ServerPolicy = Policy{
+	Methods: map[string][]string{
+		"/product.ProductService/GetProduct": {ServiceName, "transaction-service"},
+		// harga & validasi variant saat CreateTransaction
+		"/product.ProductService/GetVariant": {ServiceName, "transaction-service"},
+	},
+	Default: []string{ServiceName},
+}
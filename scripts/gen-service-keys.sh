@@ -0,0 +1,19 @@
+#!/bin/sh
+# Membuat key Ed25519 untuk service token antar service (svcauth).
+# Private key tiap service hanya di-mount ke service itu sendiri (docker compose secrets),
+# public key semua service di-mount ke semua service untuk verifikasi.
+# Key yang sudah ada tidak ditimpa; hapus file-nya dulu untuk merotasi.
+set -eu
+
+dir="${1:-$(dirname "$0")/../secrets/service-auth}"
+mkdir -p "$dir/public"
+
+for svc in auth-service user-service address-service search-service product-service \
+	cart-service transaction-service payment-service; do
+	if [ ! -f "$dir/$svc.key" ]; then
+		openssl genpkey -algorithm ed25519 -out "$dir/$svc.key"
+		chmod 600 "$dir/$svc.key"
+		echo "created $dir/$svc.key"
+	fi
+	openssl pkey -in "$dir/$svc.key" -pubout -out "$dir/public/$svc.pub"
+done
@@ -66,7 +66,7 @@ func (es *ElasticClient) SearchAddresses(query string) ([]map[string]interface{}
 		"query": map[string]interface{}{
 			"multi_match": map[string]interface{}{
 				"query":  query,
-				"fields": []string{"name", "desc"},
+				"fields": []string{"name", "desc", "recipient", "street_line1", "street_line2", "city", "province", "postal_code"},
 			},
 		},
 	}
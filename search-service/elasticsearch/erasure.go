@@ -0,0 +1,80 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// EraseUser menghapus dokumen user dan semua alamat miliknya dari index (event user.deleted).
+// Index yang belum ada (404) dianggap tidak menyimpan data user tersebut.
+func (es *ElasticClient) EraseUser(userID string) (map[string]int64, error) {
+	details := map[string]int64{}
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		"DELETE",
+		fmt.Sprintf("%s/user/_doc/%s", es.BaseURL, userID),
+		nil,
+	)
+	if err != nil {
+		return details, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return details, err
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		details["user_documents_deleted"] = 0
+	case resp.StatusCode >= 300:
+		return details, fmt.Errorf("failed to delete user: %s", resp.Status)
+	default:
+		details["user_documents_deleted"] = 1
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{
+			"term": map[string]interface{}{"owner_id": userID},
+		},
+	})
+	req, err = http.NewRequestWithContext(
+		context.Background(),
+		"POST",
+		fmt.Sprintf("%s/address/_delete_by_query?conflicts=proceed&refresh=true", es.BaseURL),
+		bytes.NewBuffer(body),
+	)
+	if err != nil {
+		return details, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		return details, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		details["address_documents_deleted"] = 0
+		return details, nil
+	}
+	if resp.StatusCode >= 300 {
+		return details, fmt.Errorf("failed to delete addresses: %s", resp.Status)
+	}
+
+	var result struct {
+		Deleted int64 `json:"deleted"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return details, err
+	}
+	details["address_documents_deleted"] = result.Deleted
+
+	log.Printf("Erased user %s from Elasticsearch", userID)
+	return details, nil
+}
@@ -64,6 +64,7 @@ func (es *ElasticClient) DeleteProduct(id string) error {
 func (es *ElasticClient) SearchProducts(
 	query string,
 	categoryID string,
+	includeDescendants bool,
 	minPrice string,
 	maxPrice string,
 ) ([]map[string]interface{}, error) {
@@ -80,13 +81,17 @@ func (es *ElasticClient) SearchProducts(
 		"filter": []interface{}{},
 	}
 
-	// filter category
+	// filter category; category_path berisi id kategori produk beserta semua leluhurnya
 	if categoryID != "" {
+		field := "category_id"
+		if includeDescendants {
+			field = "category_path"
+		}
 		boolQuery["filter"] = append(
 			boolQuery["filter"].([]interface{}),
 			map[string]interface{}{
 				"term": map[string]interface{}{
-					"category_id": categoryID,
+					field: categoryID,
 				},
 			},
 		)
@@ -41,6 +41,42 @@ func (es *ElasticClient) IndexUser(data map[string]interface{}) error {
     return nil
 }
 
+// UpdateUser hanya menimpa field yang dikirim, karena event user_updated
+// dari auth-service dan user-service masing-masing membawa sebagian field saja.
+func (es *ElasticClient) UpdateUser(data map[string]interface{}) error {
+	id := fmt.Sprintf("%v", data["id"])
+	body := map[string]interface{}{
+		"doc":           data,
+		"doc_as_upsert": true,
+	}
+
+	b, _ := json.Marshal(body)
+
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		"POST",
+		fmt.Sprintf("%s/user/_update/%s", es.BaseURL, id),
+		bytes.NewBuffer(b),
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to update user: %s", resp.Status)
+	}
+
+	log.Printf("Updated user %s in Elasticsearch", id)
+	return nil
+}
+
 func (es *ElasticClient) DeleteUser(id string) error {
 	req, err := http.NewRequestWithContext(
 		context.Background(),
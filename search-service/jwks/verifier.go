@@ -0,0 +1,230 @@
+package jwks
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// key di-refresh berkala walaupun tidak ada kid baru
+	maxAge = 10 * time.Minute
+	// kid yang tidak dikenal memicu refresh, tapi dibatasi supaya tidak membanjiri auth-service
+	minRefreshInterval = 30 * time.Second
+)
+
+type Claims struct {
+	UserID      uint32
+	Email       string
+	Role        string
+	Permissions []string
+	JTI         string
+	IssuedAt    int64 // mikrodetik unix, dibandingkan dengan cutoff revoke sessions
+	ExpiresAt   int64
+	// ImpersonatorID terisi (claim act.sub) kalau token diterbitkan untuk staf support
+	ImpersonatorID uint32
+}
+
+// Verifier memverifikasi access token secara offline memakai public key
+// dari endpoint JWKS auth-service.
+type Verifier struct {
+	URL    string
+	Issuer string
+	Client *http.Client
+
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+func NewVerifier() *Verifier {
+	return &Verifier{
+		URL:    getEnv("AUTH_JWKS_URL", "http://auth-service:3002/.well-known/jwks.json"),
+		Issuer: getEnv("JWT_ISSUER", "auth-service"),
+		Client: &http.Client{Timeout: 5 * time.Second},
+		keys:   map[string]*rsa.PublicKey{},
+	}
+}
+
+var (
+	defaultOnce     sync.Once
+	defaultVerifier *Verifier
+)
+
+// Default mengembalikan verifier bersama untuk satu proses.
+func Default() *Verifier {
+	defaultOnce.Do(func() {
+		defaultVerifier = NewVerifier()
+	})
+	return defaultVerifier
+}
+
+func (v *Verifier) Verify(tokenStr string) (*Claims, error) {
+	token, err := jwt.Parse(tokenStr, v.keyfunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(v.Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+
+	mc, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid claims")
+	}
+	sub, ok := mc["sub"].(float64)
+	if !ok {
+		return nil, errors.New("invalid sub claim")
+	}
+	// challenge token 2FA dan token lain selain access token ditolak
+	if typ, _ := mc["typ"].(string); typ != "access" {
+		return nil, errors.New("invalid token type")
+	}
+
+	claims := &Claims{
+		UserID:    uint32(sub),
+		IssuedAt:  claimMicros(mc, "iat"),
+		ExpiresAt: claimInt64(mc, "exp"),
+	}
+	claims.Email, _ = mc["email"].(string)
+	claims.Role, _ = mc["role"].(string)
+	claims.JTI, _ = mc["jti"].(string)
+	if act, ok := mc["act"].(map[string]interface{}); ok {
+		actor, _ := act["sub"].(float64)
+		claims.ImpersonatorID = uint32(actor)
+	}
+	if perms, ok := mc["perms"].([]interface{}); ok {
+		for _, p := range perms {
+			if name, ok := p.(string); ok {
+				claims.Permissions = append(claims.Permissions, name)
+			}
+		}
+	}
+	return claims, nil
+}
+
+func (v *Verifier) keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("missing kid")
+	}
+
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	stale := time.Since(v.fetchedAt) > maxAge
+	v.mu.RUnlock()
+
+	if ok && !stale {
+		return key, nil
+	}
+
+	v.refresh(!ok)
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown kid %q", kid)
+}
+
+// refresh mengambil ulang JWKS. Kalau gagal, key lama tetap dipakai.
+func (v *Verifier) refresh(unknownKid bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	since := time.Since(v.fetchedAt)
+	if unknownKid && since < minRefreshInterval {
+		return
+	}
+	if !unknownKid && since <= maxAge {
+		// sudah di-refresh goroutine lain
+		return
+	}
+
+	keys, err := v.fetch()
+	v.fetchedAt = time.Now()
+	if err != nil {
+		log.Printf("failed to fetch JWKS from %s: %v", v.URL, err)
+		return
+	}
+	v.keys = keys
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (v *Verifier) fetch() (map[string]*rsa.PublicKey, error) {
+	resp, err := v.Client.Get(v.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return keys, nil
+}
+
+// ====================== HELPER ======================
+
+func claimInt64(claims jwt.MapClaims, key string) int64 {
+	v, _ := claims[key].(float64)
+	return int64(v)
+}
+
+// claimMicros membaca claim NumericDate (boleh pecahan) sebagai mikrodetik unix.
+func claimMicros(claims jwt.MapClaims, key string) int64 {
+	v, _ := claims[key].(float64)
+	return int64(math.Round(v * 1e6))
+}
+
+func getEnv(key, def string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	return def
+}
@@ -8,9 +8,12 @@ import (
 	"os"
 	"time"
 
+	"search-service/cache"
 	"search-service/elasticsearch"
+	kafkax "search-service/kafka"
 	"search-service/middleware"
 	"search-service/routes"
+	"search-service/svcauth"
 
 	"github.com/IBM/sarama"
 	"github.com/gofiber/fiber/v2"
@@ -47,9 +50,13 @@ func connectKafka(broker string) sarama.ConsumerGroup {
 	return nil
 }
 
+// erasure yang gagal (mis. Elasticsearch sedang mati) dicoba ulang dulu sebelum dilaporkan failed
+const maxHandlerAttempts = 5
+
 // ==== Consumer Handler ====
 type ConsumerHandler struct {
 	esClient *elasticsearch.ElasticClient
+	producer *kafkax.Producer
 }
 
 func (h *ConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
@@ -135,11 +142,44 @@ func (h *ConsumerHandler) ConsumeClaim(session sarama.ConsumerGroupSession, clai
 			}
 
 			
-		case "user_created", "user_updated":
+		case "user_created":
 			if err := h.esClient.IndexUser(data); err != nil {
 				log.Printf("E34 Failed to index user: %v", err)
 			}
 
+		case "user_updated":
+			if err := h.esClient.UpdateUser(data); err != nil {
+				log.Printf("❌ Failed to update user: %v", err)
+			}
+
+		case "user_deleted":
+			userID, ok := data["user_id"]
+			if !ok {
+				log.Println("user_deleted missing user_id")
+				continue
+			}
+
+			details, err := h.eraseUser(session.Context(), fmt.Sprintf("%v", userID))
+			if session.Context().Err() != nil {
+				// session berakhir di tengah retry: offset tidak di-commit supaya diulang
+				return nil
+			}
+			report := map[string]interface{}{
+				"request_id":   data["request_id"],
+				"user_id":      userID,
+				"service":      "search-service",
+				"status":       "completed",
+				"details":      details,
+				"completed_at": time.Now().UTC().Format(time.RFC3339),
+			}
+			if err != nil {
+				report["status"] = "failed"
+				report["error"] = err.Error()
+			}
+			h.producer.PublishErasureCompletedEvent(map[string]interface{}{
+				"event_type": "user_erasure_completed",
+				"data":       report,
+			})
 
 		default:
 			log.Printf("Unknown event_type: %s", eventType)
@@ -151,13 +191,40 @@ func (h *ConsumerHandler) ConsumeClaim(session sarama.ConsumerGroupSession, clai
 	return nil
 }
 
+// eraseUser mencoba EraseUser sampai maxHandlerAttempts kali dengan backoff sebelum offset
+// di-commit; laporan failed baru dikirim setelah percobaan habis. Berhenti lebih awal kalau
+// session berakhir.
+func (h *ConsumerHandler) eraseUser(ctx context.Context, userID string) (map[string]int64, error) {
+	backoff := time.Second
+	for attempt := 1; ; attempt++ {
+		details, err := h.esClient.EraseUser(userID)
+		if err == nil {
+			return details, nil
+		}
+		if attempt >= maxHandlerAttempts {
+			log.Printf("❌ Failed to erase user %s after %d attempts: %v", userID, attempt, err)
+			return details, err
+		}
+
+		log.Printf("Retrying erase of user %s (attempt %d): %v", userID, attempt, err)
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
 // ==== MAIN ====
 func main() {
+	svcauth.Init()
 	broker := getEnv("KAFKA_BROKER", "kafka:9092")
 	esHost := getEnv("ELASTICSEARCH_HOST", "http://elasticsearch:9200")
 
 	log.Println("Starting search-service...")
 	esClient := elasticsearch.NewElasticClient(esHost)
+	cache.ConnectRedis()
 
 
 	go func() {
@@ -173,7 +240,7 @@ func main() {
 	}()
 
 	consumerGroup := connectKafka(broker)
-	handler := &ConsumerHandler{esClient: esClient}
+	handler := &ConsumerHandler{esClient: esClient, producer: kafkax.NewProducer()}
 
 	ctx := context.Background()
 	topics := []string{
@@ -182,6 +249,7 @@ func main() {
     "address.deleted",
 	"user.created",
 	"user.updated",
+	"user.deleted",
 	"product.created",
 	"product.updated",
 	"product.deleted",
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"context"
+	authpb "search-service/proto/auth"
+	"search-service/svcauth"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// API key tidak bisa diverifikasi offline seperti JWT, jadi diteruskan ke auth-service.
+func newAuthClient() authpb.AuthServiceClient {
+	conn, err := grpc.Dial("auth-service:50052", grpc.WithInsecure(), svcauth.DialOption())
+	if err != nil {
+		panic("failed to connect to auth-service gRPC: " + err.Error())
+	}
+	return authpb.NewAuthServiceClient(conn)
+}
+
+func validateAPIKey(client authpb.AuthServiceClient, key string) (*authpb.ValidateTokenResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	return client.ValidateToken(ctx, &authpb.ValidateTokenRequest{ApiKey: key})
+}
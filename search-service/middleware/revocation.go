@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"fmt"
+	"search-service/cache"
+	"search-service/jwks"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// isRevoked membaca denylist yang ditulis auth-service saat logout / revoke sessions.
+// Format key harus sama dengan auth-service/grpc_server/revocation.go.
+func isRevoked(claims *jwks.Claims) (bool, error) {
+	if claims.JTI != "" {
+		n, err := cache.Redis.Exists(cache.Ctx, fmt.Sprintf("auth:revoked:jti:%s", claims.JTI)).Result()
+		if err != nil {
+			return false, err
+		}
+		if n > 0 {
+			return true, nil
+		}
+	}
+
+	val, err := cache.Redis.Get(cache.Ctx, fmt.Sprintf("auth:revoked:user:%d", claims.UserID)).Result()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	cutoff, _ := strconv.ParseInt(val, 10, 64)
+	if cutoff < 1e12 {
+		// cutoff lama masih dalam detik
+		cutoff = cutoff*1e6 + 999999
+	}
+	return claims.IssuedAt <= cutoff, nil
+}
+
+// isSuspended mengecek flag suspend akun yang ditulis auth-service (auth:suspended:user:<id>).
+func isSuspended(claims *jwks.Claims) (bool, error) {
+	n, err := cache.Redis.Exists(cache.Ctx, fmt.Sprintf("auth:suspended:user:%d", claims.UserID)).Result()
+	return n > 0, err
+}
@@ -12,7 +12,7 @@ func RegisterSearchRoutes(app *fiber.App, authMiddleware fiber.Handler, esClient
 	s := api.Group("/search")
 
 	//admin only
-	s.Get("/address", authMiddleware, middleware.RoleRequired("admin"), func(c *fiber.Ctx) error {
+	s.Get("/address", authMiddleware, middleware.RequirePermission("address:read_all"), func(c *fiber.Ctx) error {
 		q := c.Query("q")
 		if q == "" {
 			return c.Status(400).JSON(fiber.Map{"error": "missing query parameter ?q="})
@@ -24,7 +24,7 @@ func RegisterSearchRoutes(app *fiber.App, authMiddleware fiber.Handler, esClient
 		}
 		return c.JSON(results)
 	})
-	s.Get("/user", authMiddleware, middleware.RoleRequired("admin"), func(c *fiber.Ctx) error {
+	s.Get("/user", authMiddleware, middleware.RequirePermission("user:read_all"), func(c *fiber.Ctx) error {
     q := c.Query("q")
     results, err := esClient.SearchUsers(q)
     if err != nil {
@@ -35,6 +35,7 @@ func RegisterSearchRoutes(app *fiber.App, authMiddleware fiber.Handler, esClient
 	s.Get("/product", func(c *fiber.Ctx) error {
 	q := c.Query("q")
 	categoryID := c.Query("category_id")
+	includeDescendants := c.QueryBool("include_descendants")
 	minPrice := c.Query("min_price")
 	maxPrice := c.Query("max_price")
 
@@ -42,7 +43,7 @@ func RegisterSearchRoutes(app *fiber.App, authMiddleware fiber.Handler, esClient
 		return c.Status(400).JSON(fiber.Map{"error": "missing query parameter ?q="})
 	}
 
-	results, err := esClient.SearchProducts(q, categoryID, minPrice, maxPrice)
+	results, err := esClient.SearchProducts(q, categoryID, includeDescendants, minPrice, maxPrice)
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
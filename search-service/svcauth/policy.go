@@ -0,0 +1,11 @@
+package svcauth
+
+// ServiceName dipakai sebagai identitas service ini di service token.
+const ServiceName = "search-service"
+
+// ServerPolicy adalah allowlist caller untuk RPC yang di-serve service ini.
+// search-service belum punya gRPC server, policy ini hanya placeholder.
+var ServerPolicy = Policy{
+	Methods: map[string][]string{},
+	Default: []string{ServiceName},
+}
@@ -7,6 +7,7 @@ import (
 
 	"transaction-service/grpc_client"
 	pb "transaction-service/proto/transaction"
+	"transaction-service/svcauth"
 
 	"github.com/gofiber/fiber/v2"
 	"google.golang.org/grpc"
@@ -25,7 +26,7 @@ type TransactionController struct {
 
 func NewTransactionController() *TransactionController {
 	// Connect to transaction gRPC service
-	conn, err := grpc.Dial("transaction-service:50056", grpc.WithInsecure())
+	conn, err := grpc.Dial("transaction-service:50056", grpc.WithInsecure(), svcauth.DialOption())
 	if err != nil {
 		panic("failed to connect transaction gRPC: " + err.Error())
 	}
@@ -63,6 +64,14 @@ func (tc *TransactionController) Create(c *fiber.Ctx) error {
     })
 
     if err != nil {
+        switch status.Code(err) {
+        case codes.NotFound:
+            return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+        case codes.FailedPrecondition:
+            return c.Status(409).JSON(fiber.Map{"error": status.Convert(err).Message()})
+        case codes.Unavailable:
+            return c.Status(503).JSON(fiber.Map{"error": status.Convert(err).Message()})
+        }
         return c.Status(500).JSON(fiber.Map{
             "error": err.Error(),
         })
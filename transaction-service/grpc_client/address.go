@@ -6,6 +6,7 @@ import (
 	"time"
 
 	pb "transaction-service/proto/address"
+	"transaction-service/svcauth"
 
 	"google.golang.org/grpc"
 )
@@ -15,7 +16,7 @@ type AddressClient struct {
 }
 
 func NewAddressClient() *AddressClient {
-	conn, err := grpc.Dial("address-service:50053", grpc.WithInsecure())
+	conn, err := grpc.Dial("address-service:50053", grpc.WithInsecure(), svcauth.DialOption())
 	if err != nil {
 		log.Fatalf("could not connect to address-service: %v", err)
 	}
@@ -30,6 +31,30 @@ type AddressInfo struct {
 	Desc      string
 	OwnerId   uint32
 	CreatedAt string
+	Version   uint32
+
+	Recipient   string
+	Phone       string
+	StreetLine1 string
+	StreetLine2 string
+	City        string
+	Province    string
+	PostalCode  string
+	CountryCode string
+	Latitude    *float64
+	Longitude   *float64
+}
+
+// Complete false untuk alamat lama yang belum diisi field terstrukturnya.
+func (a *AddressInfo) Complete() bool {
+	return a.Recipient != "" && a.Phone != "" && a.StreetLine1 != "" && a.City != "" && a.CountryCode != ""
+}
+
+// Legacy true untuk alamat dari sebelum ada field terstruktur (hanya name / desc free-text).
+// Alamat yang terisi sebagian bukan legacy, user sudah mulai mengisinya.
+func (a *AddressInfo) Legacy() bool {
+	return a.Recipient == "" && a.Phone == "" && a.StreetLine1 == "" && a.StreetLine2 == "" &&
+		a.City == "" && a.Province == "" && a.PostalCode == "" && a.CountryCode == ""
 }
 
 func (ac *AddressClient) GetAddress(id uint32, ownerID uint32) (*AddressInfo, error) {
@@ -44,13 +69,58 @@ func (ac *AddressClient) GetAddress(id uint32, ownerID uint32) (*AddressInfo, er
 		return nil, err
 	}
 
-	addr := res.GetAddress()
+	return toAddressInfo(res.GetAddress()), nil
+}
+
+// GetDefaultAddress mengambil alamat default user untuk kind shipping / billing.
+func (ac *AddressClient) GetDefaultAddress(ownerID uint32, kind string) (*AddressInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancel()
+
+	res, err := ac.client.GetDefaultAddress(ctx, &pb.GetDefaultAddressRequest{
+		OwnerId: ownerID,
+		Type:    kind,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAddressInfo(res.GetAddress()), nil
+}
+
+// CheckServiceability mengecek apakah alamat berada dalam jangkauan pengiriman.
+// reason berisi alasan kalau tidak serviceable.
+func (ac *AddressClient) CheckServiceability(id uint32, ownerID uint32) (bool, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	res, err := ac.client.CheckServiceability(ctx, &pb.CheckServiceabilityRequest{
+		AddressId: id,
+		OwnerId:   ownerID,
+	})
+	if err != nil {
+		return false, "", err
+	}
+	return res.Serviceable, res.Reason, nil
+}
 
+func toAddressInfo(addr *pb.Address) *AddressInfo {
 	return &AddressInfo{
 		Id:        addr.Id,
 		Name:      addr.Name,
 		Desc:      addr.Desc,
 		OwnerId:   addr.OwnerId,
 		CreatedAt: addr.CreatedAt,
-	}, nil
+		Version:   addr.Version,
+
+		Recipient:   addr.Recipient,
+		Phone:       addr.Phone,
+		StreetLine1: addr.StreetLine1,
+		StreetLine2: addr.StreetLine2,
+		City:        addr.City,
+		Province:    addr.Province,
+		PostalCode:  addr.PostalCode,
+		CountryCode: addr.CountryCode,
+		Latitude:    addr.Latitude,
+		Longitude:   addr.Longitude,
+	}
 }
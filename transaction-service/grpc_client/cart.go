@@ -6,6 +6,7 @@ import (
 	"time"
 
 	pb "transaction-service/proto/cart"
+	"transaction-service/svcauth"
 
 	"google.golang.org/grpc"
 )
@@ -15,7 +16,7 @@ type CartClient struct {
 }
 
 func NewCartClient() *CartClient {
-	conn, err := grpc.Dial("cart-service:50055", grpc.WithInsecure())
+	conn, err := grpc.Dial("cart-service:50055", grpc.WithInsecure(), svcauth.DialOption())
 	if err != nil {
 		log.Fatalf("could not connect to cart-service: %v", err)
 	}
@@ -25,8 +26,9 @@ func NewCartClient() *CartClient {
 }
 
 type CartProductInfo struct {
-	Id  uint32
-	Qty uint32
+	Id        uint32
+	Qty       uint32
+	VariantId uint32
 }
 
 type CartInfo struct {
@@ -55,8 +57,9 @@ func (cc *CartClient) GetCart(cartID uint32, ownerID uint32) (*CartInfo, error)
 	var products []CartProductInfo
 	for _, p := range cart.Products {
 		products = append(products, CartProductInfo{
-			Id:  p.Id,
-			Qty: p.Qty,
+			Id:        p.Id,
+			Qty:       p.Qty,
+			VariantId: p.VariantId,
 		})
 	}
 
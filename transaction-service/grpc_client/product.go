@@ -6,6 +6,7 @@ import (
 	"time"
 
 	pb "transaction-service/proto/product"
+	"transaction-service/svcauth"
 
 	"google.golang.org/grpc"
 )
@@ -15,7 +16,7 @@ type ProductClient struct {
 }
 
 func NewProductClient() *ProductClient {
-	conn, err := grpc.Dial("product-service:50054", grpc.WithInsecure())
+	conn, err := grpc.Dial("product-service:50054", grpc.WithInsecure(), svcauth.DialOption())
 	if err != nil {
 		log.Fatalf("could not connect to product-service: %v", err)
 	}
@@ -31,6 +32,17 @@ type ProductInfo struct {
 	Price      uint32
 	CategoryId uint32
 	CreatedAt  string
+	// produk bervariant hanya bisa dibeli lewat variant-nya
+	HasVariants bool
+}
+
+type VariantInfo struct {
+	Id        uint32
+	ProductId uint32
+	Sku       string
+	Price     uint32
+	Stock     int32
+	Options   map[string]string
 }
 
 func (pc *ProductClient) GetProduct(id uint32) (*ProductInfo, error) {
@@ -50,5 +62,28 @@ func (pc *ProductClient) GetProduct(id uint32) (*ProductInfo, error) {
 		Price:      product.Price,
 		CategoryId: product.CategoryId,
 		CreatedAt:  product.CreatedAt,
+
+		HasVariants: product.HasVariants,
+	}, nil
+}
+
+// GetVariant mengambil variant (SKU) untuk harga dan snapshot order.
+func (pc *ProductClient) GetVariant(id uint32) (*VariantInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	res, err := pc.client.GetVariant(ctx, &pb.GetVariantRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	v := res.GetVariant()
+	return &VariantInfo{
+		Id:        v.Id,
+		ProductId: v.ProductId,
+		Sku:       v.Sku,
+		Price:     v.Price,
+		Stock:     v.Stock,
+		Options:   v.Options,
 	}, nil
 }
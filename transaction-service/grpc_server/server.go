@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"log"
 	"time"
 
 	grpc_client "transaction-service/grpc_client"
@@ -29,6 +30,10 @@ type TransactionServer struct {
 	CartClient    *grpc_client.CartClient
 	AddressClient *grpc_client.AddressClient
 	ProductClient *grpc_client.ProductClient
+
+	// AllowLegacyAddresses menerima alamat lama (hanya name / desc) tanpa cek serviceability.
+	// Default false: alamat lama harus dilengkapi dulu.
+	AllowLegacyAddresses bool
 }
 
 func NewTransactionServer(db *sql.DB, prod *kafka.Producer, rdb *redis.Client) *TransactionServer {
@@ -46,16 +51,57 @@ func NewTransactionServer(db *sql.DB, prod *kafka.Producer, rdb *redis.Client) *
 
 func (s *TransactionServer) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (*pb.TransactionResponse, error) {
 
-	// Address snapshot
-	addrInfo, err := s.AddressClient.GetAddress(req.AddressId, req.UserId)
+	// Address snapshot; tanpa address_id pakai alamat default shipping user
+	var addrInfo *grpc_client.AddressInfo
+	var err error
+	if req.AddressId == 0 {
+		addrInfo, err = s.AddressClient.GetDefaultAddress(req.UserId, "shipping")
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.FailedPrecondition, "address_id is required when no default shipping address is set")
+		}
+	} else {
+		addrInfo, err = s.AddressClient.GetAddress(req.AddressId, req.UserId)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "address not found: %v", err)
 	}
+	if addrInfo.Legacy() {
+		// serviceability tidak bisa dicek tanpa field terstruktur
+		if !s.AllowLegacyAddresses {
+			return nil, status.Errorf(codes.FailedPrecondition, "address %d is incomplete, please complete the address with the structured fields", addrInfo.Id)
+		}
+		log.Printf("transaction for user %d uses legacy address %d without structured fields", req.UserId, addrInfo.Id)
+	} else {
+		// kurir menolak alamat tanpa penerima / jalan / kota, minta user melengkapinya dulu
+		if !addrInfo.Complete() {
+			return nil, status.Errorf(codes.FailedPrecondition, "address %d is incomplete, please update it with the structured fields", addrInfo.Id)
+		}
+		// ditolak sebelum transaksi dibuat, bukan saat kurir menolak paketnya
+		serviceable, reason, err := s.AddressClient.CheckServiceability(addrInfo.Id, req.UserId)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "serviceability check failed: %v", err)
+		}
+		if !serviceable {
+			return nil, status.Errorf(codes.FailedPrecondition, "address %d is not serviceable: %s", addrInfo.Id, reason)
+		}
+	}
 
 	addrSnap := model.AddressSnapshot{
-		AddressID: addrInfo.Id,
-		Name:      addrInfo.Name,
-		Desc:      addrInfo.Desc,
+		AddressID:   addrInfo.Id,
+		Name:        addrInfo.Name,
+		Desc:        addrInfo.Desc,
+		Recipient:   addrInfo.Recipient,
+		Phone:       addrInfo.Phone,
+		StreetLine1: addrInfo.StreetLine1,
+		StreetLine2: addrInfo.StreetLine2,
+		City:        addrInfo.City,
+		Province:    addrInfo.Province,
+		PostalCode:  addrInfo.PostalCode,
+		CountryCode: addrInfo.CountryCode,
+		Latitude:    addrInfo.Latitude,
+		Longitude:   addrInfo.Longitude,
+
+		AddressVersion: addrInfo.Version,
 	}
 
 	addrJSON, _ := json.Marshal(addrSnap)
@@ -79,17 +125,35 @@ func (s *TransactionServer) CreateTransaction(ctx context.Context, req *pb.Creat
 			return nil, status.Errorf(codes.NotFound, "product %d not found: %v", item.Id, err)
 		}
 
-		sub := int64(pInfo.Price) * int64(item.Qty)
-		total += sub
-
-		productSnaps = append(productSnaps, model.ProductSnapshot{
+		snap := model.ProductSnapshot{
 			ProductID:  pInfo.Id,
 			Name:       pInfo.Name,
 			Price:      int64(pInfo.Price),
 			Qty:        item.Qty,
-			Subtotal:   sub,
 			CategoryID: pInfo.CategoryId,
-		})
+		}
+
+		// produk bervariant dijual per SKU, harga diambil dari variant-nya
+		if item.VariantId != 0 {
+			vInfo, err := s.ProductClient.GetVariant(item.VariantId)
+			if err != nil {
+				return nil, status.Errorf(codes.NotFound, "variant %d not found: %v", item.VariantId, err)
+			}
+			if vInfo.ProductId != pInfo.Id {
+				return nil, status.Errorf(codes.FailedPrecondition, "variant %d does not belong to product %d", item.VariantId, pInfo.Id)
+			}
+			snap.VariantID = vInfo.Id
+			snap.SKU = vInfo.Sku
+			snap.Options = vInfo.Options
+			snap.Price = int64(vInfo.Price)
+		} else if pInfo.HasVariants {
+			return nil, status.Errorf(codes.FailedPrecondition, "product %d requires a variant", pInfo.Id)
+		}
+
+		snap.Subtotal = snap.Price * int64(item.Qty)
+		total += snap.Subtotal
+
+		productSnaps = append(productSnaps, snap)
 	}
 
 	productJSON, _ := json.Marshal(productSnaps)
@@ -447,9 +511,21 @@ func (s *TransactionServer) CancelTransaction(ctx context.Context, req *pb.Cance
 
 func toProtoAddress(a model.AddressSnapshot) *pb.AddressSnapshot {
 	return &pb.AddressSnapshot{
-		AddressId: a.AddressID,
-		Name:      a.Name,
-		Desc:      a.Desc,
+		AddressId:   a.AddressID,
+		Name:        a.Name,
+		Desc:        a.Desc,
+		Recipient:   a.Recipient,
+		Phone:       a.Phone,
+		StreetLine1: a.StreetLine1,
+		StreetLine2: a.StreetLine2,
+		City:        a.City,
+		Province:    a.Province,
+		PostalCode:  a.PostalCode,
+		CountryCode: a.CountryCode,
+		Latitude:    a.Latitude,
+		Longitude:   a.Longitude,
+
+		AddressVersion: a.AddressVersion,
 	}
 }
 
@@ -463,6 +539,9 @@ func toProtoProductSnapList(list []model.ProductSnapshot) []*pb.ProductSnapshot
 			Qty:        p.Qty,
 			Subtotal:   p.Subtotal,
 			CategoryId: p.CategoryID,
+			VariantId:  p.VariantID,
+			Sku:        p.SKU,
+			Options:    p.Options,
 		})
 	}
 	return out
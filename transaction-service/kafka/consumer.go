@@ -1,6 +1,7 @@
 package kafka
 
 import (
+	"context"
 	"log"
 	"os"
 	"time"
@@ -8,55 +9,119 @@ import (
 	"github.com/IBM/sarama"
 )
 
+const (
+	consumerGroupID = "transaction-service-group"
+	// handler yang gagal (mis. DB sedang mati) dicoba ulang dulu sebelum pesan dilewati
+	maxHandlerAttempts = 5
+)
+
+// Consumer memakai consumer group supaya offset di-commit ke Kafka:
+// event yang dikirim selama transaction-service mati (mis. user.deleted) tetap diproses setelah hidup lagi.
 type Consumer struct {
-    consumer sarama.Consumer
+	group    sarama.ConsumerGroup
+	handlers map[string]func([]byte) error
 }
 
 func NewConsumer() *Consumer {
-    broker := os.Getenv("KAFKA_BROKER")
-    if broker == "" {
-        broker = "kafka:9092"
-    }
-
-    config := sarama.NewConfig()
-    config.Consumer.Return.Errors = true
-
-    var client sarama.Consumer
-    var err error
-
-    for i := 1; i <= 10; i++ {
-        client, err = sarama.NewConsumer([]string{broker}, config)
-        if err == nil {
-            log.Println("Kafka consumer initialized")
-            return &Consumer{consumer: client}
-        }
-
-        log.Printf("Waiting for Kafka consumer... (%d/10) Error: %v", i, err)
-        time.Sleep(5 * time.Second)
-    }
-
-    log.Fatalf("Failed to start Kafka consumer: %v", err)
-    return nil
+	broker := os.Getenv("KAFKA_BROKER")
+	if broker == "" {
+		broker = "kafka:9092"
+	}
+
+	config := sarama.NewConfig()
+	config.Version = sarama.V3_4_0_0
+	config.Consumer.Return.Errors = true
+	// group baru mulai dari awal topic, bukan hanya pesan baru
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	var group sarama.ConsumerGroup
+	var err error
+
+	for i := 1; i <= 10; i++ {
+		group, err = sarama.NewConsumerGroup([]string{broker}, consumerGroupID, config)
+		if err == nil {
+			log.Println("Kafka consumer group initialized")
+			return &Consumer{group: group, handlers: map[string]func([]byte) error{}}
+		}
+
+		log.Printf("Waiting for Kafka consumer... (%d/10) Error: %v", i, err)
+		time.Sleep(5 * time.Second)
+	}
+
+	log.Fatalf("Failed to start Kafka consumer: %v", err)
+	return nil
+}
+
+// Consume mendaftarkan handler untuk topic; baru mulai diproses setelah Start.
+func (c *Consumer) Consume(topic string, handler func([]byte) error) {
+	c.handlers[topic] = handler
 }
 
-// Generic consume function
-func (c *Consumer) Consume(topic string, handler func([]byte)) {
-    pc, err := c.consumer.ConsumePartition(topic, 0, sarama.OffsetNewest)
-    if err != nil {
-        log.Fatalf("Failed to consume topic %s: %v", topic, err)
-    }
-
-    log.Printf("Listening on topic %s ...", topic)
-
-    go func() {
-        for {
-            select {
-            case msg := <-pc.Messages():
-                handler(msg.Value)
-
-            case err := <-pc.Errors():
-                log.Printf("Kafka consumer error: %v", err)
-            }
-        }
-    }()
+// Start menjalankan consumer group di background sampai ctx selesai.
+func (c *Consumer) Start(ctx context.Context) {
+	topics := make([]string, 0, len(c.handlers))
+	for topic := range c.handlers {
+		topics = append(topics, topic)
+	}
+	log.Printf("Listening on topics %v ...", topics)
+
+	go func() {
+		for err := range c.group.Errors() {
+			log.Printf("Kafka consumer error: %v", err)
+		}
+	}()
+
+	go func() {
+		for {
+			// Consume selesai setiap kali rebalance, jadi dipanggil ulang dalam loop
+			if err := c.group.Consume(ctx, topics, c); err != nil {
+				log.Printf("Kafka consume error: %v", err)
+				time.Sleep(5 * time.Second)
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+}
+
+func (c *Consumer) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
+func (c *Consumer) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+
+func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	handler := c.handlers[claim.Topic()]
+	for msg := range claim.Messages() {
+		if handler != nil {
+			c.handle(session.Context(), msg, handler)
+		}
+		// session berakhir di tengah retry (rebalance / shutdown): jangan di-commit supaya diulang
+		if session.Context().Err() != nil {
+			return nil
+		}
+		// offset di-commit setelah handler selesai (at-least-once)
+		session.MarkMessage(msg, "")
+	}
+	return nil
+}
+
+func (c *Consumer) handle(ctx context.Context, msg *sarama.ConsumerMessage, handler func([]byte) error) {
+	backoff := time.Second
+	for attempt := 1; ; attempt++ {
+		err := handler(msg.Value)
+		if err == nil {
+			return
+		}
+		if attempt >= maxHandlerAttempts {
+			log.Printf("❌ giving up on %s offset %d after %d attempts: %v", msg.Topic, msg.Offset, attempt, err)
+			return
+		}
+
+		log.Printf("Retrying %s offset %d (attempt %d): %v", msg.Topic, msg.Offset, attempt, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
 }
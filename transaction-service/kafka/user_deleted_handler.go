@@ -0,0 +1,85 @@
+package kafka
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+	"transaction-service/cache"
+)
+
+const serviceName = "transaction-service"
+
+// === payload dari user-service ===
+type UserDeletedEvent struct {
+	EventType string `json:"event_type"`
+	Data      struct {
+		UserID    uint32 `json:"user_id"`
+		RequestID string `json:"request_id"`
+	} `json:"data"`
+}
+
+// publishErasureReport mengirim laporan penghapusan data (bukti per service) ke user-service.
+func publishErasureReport(producer *Producer, event UserDeletedEvent, details map[string]int64, err error) {
+	data := map[string]interface{}{
+		"request_id":   event.Data.RequestID,
+		"user_id":      event.Data.UserID,
+		"service":      serviceName,
+		"status":       "completed",
+		"details":      details,
+		"completed_at": time.Now().UTC().Format(time.RFC3339),
+	}
+	if err != nil {
+		data["status"] = "failed"
+		data["error"] = err.Error()
+	}
+
+	producer.PublishErasureCompletedEvent(map[string]interface{}{
+		"event_type": "user_erasure_completed",
+		"data":       data,
+	})
+}
+
+// UserDeletedHandler menganonimkan transaksi milik user (event user.deleted).
+// Transaksi tetap disimpan untuk keperluan pembukuan; yang dihapus hanya isi
+// snapshot alamat (nama & detail alamat), address_id tetap dipertahankan.
+func UserDeletedHandler(db *sql.DB, producer *Producer) func([]byte) error {
+	return func(msg []byte) error {
+		log.Printf("📥 user.deleted received: %s", string(msg))
+
+		var event UserDeletedEvent
+		if err := json.Unmarshal(msg, &event); err != nil {
+			log.Printf("❌ invalid user.deleted payload: %v", err)
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		query := `
+			UPDATE transactions
+			SET address_snapshot = jsonb_build_object(
+				'address_id', address_snapshot->'address_id',
+				'name', '[deleted]',
+				'desc', '[deleted]'
+			)
+			WHERE user_id=$1
+		`
+
+		details := map[string]int64{}
+		res, err := db.ExecContext(ctx, query, event.Data.UserID)
+		if err == nil {
+			details["transactions_anonymized"], _ = res.RowsAffected()
+			cache.Redis.Del(ctx, fmt.Sprintf("transactions:%d", event.Data.UserID), "transactions:all")
+			log.Printf("✅ transactions of user %d anonymized", event.Data.UserID)
+		} else {
+			log.Printf("❌ failed anonymize transactions of user %d: %v", event.Data.UserID, err)
+		}
+
+		publishErasureReport(producer, event, details, err)
+		// error dikembalikan supaya consumer mencoba ulang; laporan "failed" ditimpa saat berhasil
+		return err
+	}
+}
@@ -8,11 +8,13 @@ import (
 	"transaction-service/model"
 	pb "transaction-service/proto/transaction"
 
+	"context"
 	"database/sql"
 	"log"
 	"net"
 	"os"
 	"transaction-service/routes"
+	"transaction-service/svcauth"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/logger"
@@ -55,6 +57,7 @@ func initDB() {
 
 
 func main() {
+	svcauth.Init()
 	initDB()
 	producer := kafkax.NewProducer()
 	cache.ConnectRedis()
@@ -83,8 +86,10 @@ func main() {
         Addr: redisAddr,
  	   })
 
-		grpcServer := grpc.NewServer()
+		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(svcauth.UnaryInterceptor(svcauth.ServerPolicy)))
 		TransactionServer := grpc_server.NewTransactionServer(SQLDB, producer, rdb)
+		// masa transisi: alamat lama tanpa field terstruktur boleh checkout tanpa cek serviceability
+		TransactionServer.AllowLegacyAddresses = getEnv("ALLOW_LEGACY_ADDRESSES", "false") == "true"
 		pb.RegisterTransactionServiceServer(grpcServer, TransactionServer)
 
 
@@ -95,7 +100,10 @@ func main() {
 	}()
 	consumer := kafkax.NewConsumer()
 
-	consumer.Consume("payment.paid",kafkax.PaymentPaidHandler(SQLDB),)
+	paymentPaid := kafkax.PaymentPaidHandler(SQLDB)
+	consumer.Consume("payment.paid", func(msg []byte) error { paymentPaid(msg); return nil })
+	consumer.Consume("user.deleted", kafkax.UserDeletedHandler(SQLDB, producer))
+	consumer.Start(context.Background())
 	select {}
 }
 
@@ -1,22 +1,33 @@
 package middleware
 
 import (
-	"context"
 	"strings"
-	authpb "transaction-service/proto/auth"
+	"transaction-service/jwks"
 
 	"github.com/gofiber/fiber/v2"
-	"google.golang.org/grpc"
 )
 
 func AuthMiddleware() fiber.Handler {
-	conn, err := grpc.Dial("auth-service:50052", grpc.WithInsecure())
-	if err != nil {
-		panic("failed to connect to auth-service gRPC: " + err.Error())
-	}
-	client := authpb.NewAuthServiceClient(conn)
+	verifier := jwks.Default()
+	client := newAuthClient()
 
 	return func(c *fiber.Ctx) error {
+		// client mesin memakai API key
+		if apiKey := c.Get("X-API-Key"); apiKey != "" {
+			res, err := validateAPIKey(client, apiKey)
+			if err != nil {
+				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid api key"})
+			}
+
+			c.Locals("user_id", res.Id)
+			c.Locals("email", res.Email)
+			c.Locals("role", res.Role)
+			c.Locals("permissions", res.Permissions)
+			c.Locals("api_key_id", res.ApiKeyId)
+
+			return c.Next()
+		}
+
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "missing token"})
@@ -24,27 +35,57 @@ func AuthMiddleware() fiber.Handler {
 
 		token := strings.TrimPrefix(authHeader, "Bearer ")
 
-		res, err := client.ValidateToken(context.Background(), &authpb.ValidateTokenRequest{
-			Token: token,
-		})
+		claims, err := verifier.Verify(token)
 		if err != nil {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid token"})
 		}
 
-		c.Locals("user_id", res.Id)
-		c.Locals("email", res.Email)
-		c.Locals("role", res.Role)
+		revoked, err := isRevoked(claims)
+		if err != nil {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "unable to check token revocation"})
+		}
+		if revoked {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "token revoked"})
+		}
+
+		suspended, err := isSuspended(claims)
+		if err != nil {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "unable to check account status"})
+		}
+		if suspended {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "account suspended"})
+		}
+
+		// token impersonation hanya untuk melihat, bukan mengubah data user
+		if claims.ImpersonatorID != 0 {
+			if !isReadOnlyMethod(c.Method()) {
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "impersonation token is read-only"})
+			}
+			c.Locals("impersonator_id", claims.ImpersonatorID)
+		}
+
+		c.Locals("user_id", claims.UserID)
+		c.Locals("email", claims.Email)
+		c.Locals("role", claims.Role)
+		c.Locals("permissions", claims.Permissions)
 
 		return c.Next()
 	}
 }
 
-func RoleRequired(role string) fiber.Handler {
+func isReadOnlyMethod(method string) bool {
+	return method == fiber.MethodGet || method == fiber.MethodHead || method == fiber.MethodOptions
+}
+
+// RequirePermission mengizinkan request hanya kalau token membawa permission tersebut.
+func RequirePermission(permission string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		userRole := c.Locals("role")
-		if userRole != role {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden: admin only"})
+		perms, _ := c.Locals("permissions").([]string)
+		for _, p := range perms {
+			if p == permission {
+				return c.Next()
+			}
 		}
-		return c.Next()
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden: missing permission " + permission})
 	}
-}
\ No newline at end of file
+}
@@ -4,6 +4,21 @@ type AddressSnapshot struct {
 	AddressID uint32 `json:"address_id"`
 	Name      string `json:"name"`
 	Desc      string `json:"desc"`
+
+	// alamat terstruktur; kosong di transaksi yang dibuat sebelum field ini ada
+	Recipient   string   `json:"recipient,omitempty"`
+	Phone       string   `json:"phone,omitempty"`
+	StreetLine1 string   `json:"street_line1,omitempty"`
+	StreetLine2 string   `json:"street_line2,omitempty"`
+	City        string   `json:"city,omitempty"`
+	Province    string   `json:"province,omitempty"`
+	PostalCode  string   `json:"postal_code,omitempty"`
+	CountryCode string   `json:"country_code,omitempty"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+
+	// versi alamat di address-service, supaya isi aslinya tetap bisa dilihat walau alamat diubah / dihapus
+	AddressVersion uint32 `json:"address_version,omitempty"`
 }
 
 type ProductSnapshot struct {
@@ -13,4 +28,9 @@ type ProductSnapshot struct {
 	Qty        uint32 `json:"qty"`
 	Subtotal   int64  `json:"subtotal"`
 	CategoryID uint32 `json:"category_id"`
+
+	// variant (SKU) yang dibeli; kosong untuk produk tanpa variant
+	VariantID uint32            `json:"variant_id,omitempty"`
+	SKU       string            `json:"sku,omitempty"`
+	Options   map[string]string `json:"options,omitempty"`
 }
@@ -23,14 +23,29 @@ const (
 )
 
 type Address struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Desc          string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
-	OwnerId       uint32                 `protobuf:"varint,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Desc              string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	OwnerId           uint32                 `protobuf:"varint,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Recipient         string                 `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Phone             string                 `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"` // E.164, mis. +6281234567890
+	StreetLine1       string                 `protobuf:"bytes,8,opt,name=street_line1,json=streetLine1,proto3" json:"street_line1,omitempty"`
+	StreetLine2       string                 `protobuf:"bytes,9,opt,name=street_line2,json=streetLine2,proto3" json:"street_line2,omitempty"`
+	City              string                 `protobuf:"bytes,10,opt,name=city,proto3" json:"city,omitempty"`
+	Province          string                 `protobuf:"bytes,11,opt,name=province,proto3" json:"province,omitempty"`
+	PostalCode        string                 `protobuf:"bytes,12,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	CountryCode       string                 `protobuf:"bytes,13,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"` // ISO 3166-1 alpha-2
+	Latitude          *float64               `protobuf:"fixed64,14,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude         *float64               `protobuf:"fixed64,15,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	IsDefaultShipping bool                   `protobuf:"varint,16,opt,name=is_default_shipping,json=isDefaultShipping,proto3" json:"is_default_shipping,omitempty"`
+	IsDefaultBilling  bool                   `protobuf:"varint,17,opt,name=is_default_billing,json=isDefaultBilling,proto3" json:"is_default_billing,omitempty"`
+	Version           uint32                 `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"`                                            // naik setiap kali alamat diubah
+	DeletedAt         string                 `protobuf:"bytes,19,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                        // hanya terisi untuk versi historis alamat yang sudah dihapus
+	VersionCreatedAt  string                 `protobuf:"bytes,20,opt,name=version_created_at,json=versionCreatedAt,proto3" json:"version_created_at,omitempty"` // kapan versi ini disimpan, hanya terisi kalau GetAddress meminta version
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Address) Reset() {
@@ -98,11 +113,128 @@ func (x *Address) GetCreatedAt() string {
 	return ""
 }
 
+func (x *Address) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Address) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Address) GetStreetLine1() string {
+	if x != nil {
+		return x.StreetLine1
+	}
+	return ""
+}
+
+func (x *Address) GetStreetLine2() string {
+	if x != nil {
+		return x.StreetLine2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *Address) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Address) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *Address) GetIsDefaultShipping() bool {
+	if x != nil {
+		return x.IsDefaultShipping
+	}
+	return false
+}
+
+func (x *Address) GetIsDefaultBilling() bool {
+	if x != nil {
+		return x.IsDefaultBilling
+	}
+	return false
+}
+
+func (x *Address) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Address) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Address) GetVersionCreatedAt() string {
+	if x != nil {
+		return x.VersionCreatedAt
+	}
+	return ""
+}
+
+// name = label alamat (mis. "Rumah"), desc = catatan untuk kurir.
+// Field terstruktur divalidasi sesuai aturan negara (country_code, default ID).
 type CreateAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Desc          string                 `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
 	OwnerId       uint32                 `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Recipient     string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	StreetLine1   string                 `protobuf:"bytes,6,opt,name=street_line1,json=streetLine1,proto3" json:"street_line1,omitempty"`
+	StreetLine2   string                 `protobuf:"bytes,7,opt,name=street_line2,json=streetLine2,proto3" json:"street_line2,omitempty"`
+	City          string                 `protobuf:"bytes,8,opt,name=city,proto3" json:"city,omitempty"`
+	Province      string                 `protobuf:"bytes,9,opt,name=province,proto3" json:"province,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,10,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,11,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,12,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,13,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -158,10 +290,82 @@ func (x *CreateAddressRequest) GetOwnerId() uint32 {
 	return 0
 }
 
+func (x *CreateAddressRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetStreetLine1() string {
+	if x != nil {
+		return x.StreetLine1
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetStreetLine2() string {
+	if x != nil {
+		return x.StreetLine2
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateAddressRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
 type GetAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	OwnerId       uint32                 `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Version       uint32                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                   // 0 = versi terbaru; versi lama tetap bisa diambil walau alamat sudah dihapus
+	AnyOwner      bool                   `protobuf:"varint,4,opt,name=any_owner,json=anyOwner,proto3" json:"any_owner,omitempty"` // lewati cek owner, hanya untuk endpoint support
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,6 +414,20 @@ func (x *GetAddressRequest) GetOwnerId() uint32 {
 	return 0
 }
 
+func (x *GetAddressRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetAddressRequest) GetAnyOwner() bool {
+	if x != nil {
+		return x.AnyOwner
+	}
+	return false
+}
+
 type ListAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -254,11 +472,23 @@ func (x *ListAddressRequest) GetOwnerId() uint32 {
 	return 0
 }
 
+// semua field diganti (bukan partial update)
 type UpdateAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Desc          string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Recipient     string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	StreetLine1   string                 `protobuf:"bytes,6,opt,name=street_line1,json=streetLine1,proto3" json:"street_line1,omitempty"`
+	StreetLine2   string                 `protobuf:"bytes,7,opt,name=street_line2,json=streetLine2,proto3" json:"street_line2,omitempty"`
+	City          string                 `protobuf:"bytes,8,opt,name=city,proto3" json:"city,omitempty"`
+	Province      string                 `protobuf:"bytes,9,opt,name=province,proto3" json:"province,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,10,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,11,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,12,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,13,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	OwnerId       uint32                 `protobuf:"varint,14,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -314,6 +544,83 @@ func (x *UpdateAddressRequest) GetDesc() string {
 	return ""
 }
 
+func (x *UpdateAddressRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetStreetLine1() string {
+	if x != nil {
+		return x.StreetLine1
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetStreetLine2() string {
+	if x != nil {
+		return x.StreetLine2
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateAddressRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateAddressRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
 type DeleteAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -498,6 +805,239 @@ func (x *DeleteAddressResponse) GetMessage() string {
 	return ""
 }
 
+// type: shipping | billing | both
+type SetDefaultAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       uint32                 `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultAddressRequest) Reset() {
+	*x = SetDefaultAddressRequest{}
+	mi := &file_proto_address_address_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultAddressRequest) ProtoMessage() {}
+
+func (x *SetDefaultAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_address_address_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultAddressRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_address_address_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetDefaultAddressRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetDefaultAddressRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *SetDefaultAddressRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GetDefaultAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // shipping | billing
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDefaultAddressRequest) Reset() {
+	*x = GetDefaultAddressRequest{}
+	mi := &file_proto_address_address_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDefaultAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefaultAddressRequest) ProtoMessage() {}
+
+func (x *GetDefaultAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_address_address_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefaultAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetDefaultAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_address_address_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetDefaultAddressRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *GetDefaultAddressRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type CheckServiceabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddressId     uint32                 `protobuf:"varint,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	OwnerId       uint32                 `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckServiceabilityRequest) Reset() {
+	*x = CheckServiceabilityRequest{}
+	mi := &file_proto_address_address_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckServiceabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckServiceabilityRequest) ProtoMessage() {}
+
+func (x *CheckServiceabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_address_address_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckServiceabilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckServiceabilityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_address_address_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CheckServiceabilityRequest) GetAddressId() uint32 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *CheckServiceabilityRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+type CheckServiceabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Serviceable   bool                   `protobuf:"varint,1,opt,name=serviceable,proto3" json:"serviceable,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // kosong kalau serviceable
+	Latitude      *float64               `protobuf:"fixed64,3,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,4,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckServiceabilityResponse) Reset() {
+	*x = CheckServiceabilityResponse{}
+	mi := &file_proto_address_address_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckServiceabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckServiceabilityResponse) ProtoMessage() {}
+
+func (x *CheckServiceabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_address_address_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckServiceabilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckServiceabilityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_address_address_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckServiceabilityResponse) GetServiceable() bool {
+	if x != nil {
+		return x.Serviceable
+	}
+	return false
+}
+
+func (x *CheckServiceabilityResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CheckServiceabilityResponse) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *CheckServiceabilityResponse) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
 type GetAllAddressesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -506,7 +1046,7 @@ type GetAllAddressesRequest struct {
 
 func (x *GetAllAddressesRequest) Reset() {
 	*x = GetAllAddressesRequest{}
-	mi := &file_proto_address_address_proto_msgTypes[9]
+	mi := &file_proto_address_address_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -518,7 +1058,7 @@ func (x *GetAllAddressesRequest) String() string {
 func (*GetAllAddressesRequest) ProtoMessage() {}
 
 func (x *GetAllAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_address_address_proto_msgTypes[9]
+	mi := &file_proto_address_address_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +1071,7 @@ func (x *GetAllAddressesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllAddressesRequest.ProtoReflect.Descriptor instead.
 func (*GetAllAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_address_address_proto_rawDescGZIP(), []int{9}
+	return file_proto_address_address_proto_rawDescGZIP(), []int{13}
 }
 
 type GetAllAddressesResponse struct {
@@ -543,7 +1083,7 @@ type GetAllAddressesResponse struct {
 
 func (x *GetAllAddressesResponse) Reset() {
 	*x = GetAllAddressesResponse{}
-	mi := &file_proto_address_address_proto_msgTypes[10]
+	mi := &file_proto_address_address_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +1095,7 @@ func (x *GetAllAddressesResponse) String() string {
 func (*GetAllAddressesResponse) ProtoMessage() {}
 
 func (x *GetAllAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_address_address_proto_msgTypes[10]
+	mi := &file_proto_address_address_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +1108,7 @@ func (x *GetAllAddressesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllAddressesResponse.ProtoReflect.Descriptor instead.
 func (*GetAllAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_address_address_proto_rawDescGZIP(), []int{10}
+	return file_proto_address_address_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetAllAddressesResponse) GetAddresses() []*Address {
@@ -582,27 +1122,81 @@ var File_proto_address_address_proto protoreflect.FileDescriptor
 
 const file_proto_address_address_proto_rawDesc = "" +
 	"\n" +
-	"\x1bproto/address/address.proto\x12\aaddress\x1a\x1bgoogle/protobuf/empty.proto\"{\n" +
+	"\x1bproto/address/address.proto\x12\aaddress\x1a\x1bgoogle/protobuf/empty.proto\"\x8d\x05\n" +
 	"\aAddress\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04desc\x18\x03 \x01(\tR\x04desc\x12\x19\n" +
 	"\bowner_id\x18\x04 \x01(\rR\aownerId\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"Y\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\trecipient\x18\x06 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05phone\x18\a \x01(\tR\x05phone\x12!\n" +
+	"\fstreet_line1\x18\b \x01(\tR\vstreetLine1\x12!\n" +
+	"\fstreet_line2\x18\t \x01(\tR\vstreetLine2\x12\x12\n" +
+	"\x04city\x18\n" +
+	" \x01(\tR\x04city\x12\x1a\n" +
+	"\bprovince\x18\v \x01(\tR\bprovince\x12\x1f\n" +
+	"\vpostal_code\x18\f \x01(\tR\n" +
+	"postalCode\x12!\n" +
+	"\fcountry_code\x18\r \x01(\tR\vcountryCode\x12\x1f\n" +
+	"\blatitude\x18\x0e \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x0f \x01(\x01H\x01R\tlongitude\x88\x01\x01\x12.\n" +
+	"\x13is_default_shipping\x18\x10 \x01(\bR\x11isDefaultShipping\x12,\n" +
+	"\x12is_default_billing\x18\x11 \x01(\bR\x10isDefaultBilling\x12\x18\n" +
+	"\aversion\x18\x12 \x01(\rR\aversion\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x13 \x01(\tR\tdeletedAt\x12,\n" +
+	"\x12version_created_at\x18\x14 \x01(\tR\x10versionCreatedAtB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\xa6\x03\n" +
 	"\x14CreateAddressRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04desc\x18\x02 \x01(\tR\x04desc\x12\x19\n" +
-	"\bowner_id\x18\x03 \x01(\rR\aownerId\">\n" +
+	"\bowner_id\x18\x03 \x01(\rR\aownerId\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12!\n" +
+	"\fstreet_line1\x18\x06 \x01(\tR\vstreetLine1\x12!\n" +
+	"\fstreet_line2\x18\a \x01(\tR\vstreetLine2\x12\x12\n" +
+	"\x04city\x18\b \x01(\tR\x04city\x12\x1a\n" +
+	"\bprovince\x18\t \x01(\tR\bprovince\x12\x1f\n" +
+	"\vpostal_code\x18\n" +
+	" \x01(\tR\n" +
+	"postalCode\x12!\n" +
+	"\fcountry_code\x18\v \x01(\tR\vcountryCode\x12\x1f\n" +
+	"\blatitude\x18\f \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\r \x01(\x01H\x01R\tlongitude\x88\x01\x01B\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"u\n" +
 	"\x11GetAddressRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
-	"\bowner_id\x18\x02 \x01(\rR\aownerId\"/\n" +
+	"\bowner_id\x18\x02 \x01(\rR\aownerId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\rR\aversion\x12\x1b\n" +
+	"\tany_owner\x18\x04 \x01(\bR\banyOwner\"/\n" +
 	"\x12ListAddressRequest\x12\x19\n" +
-	"\bowner_id\x18\x01 \x01(\rR\aownerId\"N\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId\"\xb6\x03\n" +
 	"\x14UpdateAddressRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04desc\x18\x03 \x01(\tR\x04desc\"A\n" +
+	"\x04desc\x18\x03 \x01(\tR\x04desc\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12!\n" +
+	"\fstreet_line1\x18\x06 \x01(\tR\vstreetLine1\x12!\n" +
+	"\fstreet_line2\x18\a \x01(\tR\vstreetLine2\x12\x12\n" +
+	"\x04city\x18\b \x01(\tR\x04city\x12\x1a\n" +
+	"\bprovince\x18\t \x01(\tR\bprovince\x12\x1f\n" +
+	"\vpostal_code\x18\n" +
+	" \x01(\tR\n" +
+	"postalCode\x12!\n" +
+	"\fcountry_code\x18\v \x01(\tR\vcountryCode\x12\x1f\n" +
+	"\blatitude\x18\f \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\r \x01(\x01H\x01R\tlongitude\x88\x01\x01\x12\x19\n" +
+	"\bowner_id\x18\x0e \x01(\rR\aownerIdB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"A\n" +
 	"\x14DeleteAddressRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\rR\aownerId\"=\n" +
@@ -611,10 +1205,29 @@ const file_proto_address_address_proto_rawDesc = "" +
 	"\x13ListAddressResponse\x12.\n" +
 	"\taddresses\x18\x01 \x03(\v2\x10.address.AddressR\taddresses\"1\n" +
 	"\x15DeleteAddressResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"Y\n" +
+	"\x18SetDefaultAddressRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\rR\aownerId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"I\n" +
+	"\x18GetDefaultAddressRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"V\n" +
+	"\x1aCheckServiceabilityRequest\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x01 \x01(\rR\taddressId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\rR\aownerId\"\xb6\x01\n" +
+	"\x1bCheckServiceabilityResponse\x12 \n" +
+	"\vserviceable\x18\x01 \x01(\bR\vserviceable\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1f\n" +
+	"\blatitude\x18\x03 \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x04 \x01(\x01H\x01R\tlongitude\x88\x01\x01B\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\x18\n" +
 	"\x16GetAllAddressesRequest\"I\n" +
 	"\x17GetAllAddressesResponse\x12.\n" +
-	"\taddresses\x18\x01 \x03(\v2\x10.address.AddressR\taddresses2\xd1\x03\n" +
+	"\taddresses\x18\x01 \x03(\v2\x10.address.AddressR\taddresses2\xd7\x05\n" +
 	"\x0eAddressService\x12H\n" +
 	"\rCreateAddress\x12\x1d.address.CreateAddressRequest\x1a\x18.address.AddressResponse\x12B\n" +
 	"\n" +
@@ -622,7 +1235,10 @@ const file_proto_address_address_proto_rawDesc = "" +
 	"\rListAddresses\x12\x1b.address.ListAddressRequest\x1a\x1c.address.ListAddressResponse\x12H\n" +
 	"\rUpdateAddress\x12\x1d.address.UpdateAddressRequest\x1a\x18.address.AddressResponse\x12N\n" +
 	"\rDeleteAddress\x12\x1d.address.DeleteAddressRequest\x1a\x1e.address.DeleteAddressResponse\x12K\n" +
-	"\x0fGetAllAddresses\x12\x16.google.protobuf.Empty\x1a .address.GetAllAddressesResponseB\x10Z\x0eproto/address/b\x06proto3"
+	"\x0fGetAllAddresses\x12\x16.google.protobuf.Empty\x1a .address.GetAllAddressesResponse\x12P\n" +
+	"\x11SetDefaultAddress\x12!.address.SetDefaultAddressRequest\x1a\x18.address.AddressResponse\x12P\n" +
+	"\x11GetDefaultAddress\x12!.address.GetDefaultAddressRequest\x1a\x18.address.AddressResponse\x12`\n" +
+	"\x13CheckServiceability\x12#.address.CheckServiceabilityRequest\x1a$.address.CheckServiceabilityResponseB\x10Z\x0eproto/address/b\x06proto3"
 
 var (
 	file_proto_address_address_proto_rawDescOnce sync.Once
@@ -636,20 +1252,24 @@ func file_proto_address_address_proto_rawDescGZIP() []byte {
 	return file_proto_address_address_proto_rawDescData
 }
 
-var file_proto_address_address_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_address_address_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_proto_address_address_proto_goTypes = []any{
-	(*Address)(nil),                 // 0: address.Address
-	(*CreateAddressRequest)(nil),    // 1: address.CreateAddressRequest
-	(*GetAddressRequest)(nil),       // 2: address.GetAddressRequest
-	(*ListAddressRequest)(nil),      // 3: address.ListAddressRequest
-	(*UpdateAddressRequest)(nil),    // 4: address.UpdateAddressRequest
-	(*DeleteAddressRequest)(nil),    // 5: address.DeleteAddressRequest
-	(*AddressResponse)(nil),         // 6: address.AddressResponse
-	(*ListAddressResponse)(nil),     // 7: address.ListAddressResponse
-	(*DeleteAddressResponse)(nil),   // 8: address.DeleteAddressResponse
-	(*GetAllAddressesRequest)(nil),  // 9: address.GetAllAddressesRequest
-	(*GetAllAddressesResponse)(nil), // 10: address.GetAllAddressesResponse
-	(*emptypb.Empty)(nil),           // 11: google.protobuf.Empty
+	(*Address)(nil),                     // 0: address.Address
+	(*CreateAddressRequest)(nil),        // 1: address.CreateAddressRequest
+	(*GetAddressRequest)(nil),           // 2: address.GetAddressRequest
+	(*ListAddressRequest)(nil),          // 3: address.ListAddressRequest
+	(*UpdateAddressRequest)(nil),        // 4: address.UpdateAddressRequest
+	(*DeleteAddressRequest)(nil),        // 5: address.DeleteAddressRequest
+	(*AddressResponse)(nil),             // 6: address.AddressResponse
+	(*ListAddressResponse)(nil),         // 7: address.ListAddressResponse
+	(*DeleteAddressResponse)(nil),       // 8: address.DeleteAddressResponse
+	(*SetDefaultAddressRequest)(nil),    // 9: address.SetDefaultAddressRequest
+	(*GetDefaultAddressRequest)(nil),    // 10: address.GetDefaultAddressRequest
+	(*CheckServiceabilityRequest)(nil),  // 11: address.CheckServiceabilityRequest
+	(*CheckServiceabilityResponse)(nil), // 12: address.CheckServiceabilityResponse
+	(*GetAllAddressesRequest)(nil),      // 13: address.GetAllAddressesRequest
+	(*GetAllAddressesResponse)(nil),     // 14: address.GetAllAddressesResponse
+	(*emptypb.Empty)(nil),               // 15: google.protobuf.Empty
 }
 var file_proto_address_address_proto_depIdxs = []int32{
 	0,  // 0: address.AddressResponse.address:type_name -> address.Address
@@ -660,15 +1280,21 @@ var file_proto_address_address_proto_depIdxs = []int32{
 	3,  // 5: address.AddressService.ListAddresses:input_type -> address.ListAddressRequest
 	4,  // 6: address.AddressService.UpdateAddress:input_type -> address.UpdateAddressRequest
 	5,  // 7: address.AddressService.DeleteAddress:input_type -> address.DeleteAddressRequest
-	11, // 8: address.AddressService.GetAllAddresses:input_type -> google.protobuf.Empty
-	6,  // 9: address.AddressService.CreateAddress:output_type -> address.AddressResponse
-	6,  // 10: address.AddressService.GetAddress:output_type -> address.AddressResponse
-	7,  // 11: address.AddressService.ListAddresses:output_type -> address.ListAddressResponse
-	6,  // 12: address.AddressService.UpdateAddress:output_type -> address.AddressResponse
-	8,  // 13: address.AddressService.DeleteAddress:output_type -> address.DeleteAddressResponse
-	10, // 14: address.AddressService.GetAllAddresses:output_type -> address.GetAllAddressesResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
+	15, // 8: address.AddressService.GetAllAddresses:input_type -> google.protobuf.Empty
+	9,  // 9: address.AddressService.SetDefaultAddress:input_type -> address.SetDefaultAddressRequest
+	10, // 10: address.AddressService.GetDefaultAddress:input_type -> address.GetDefaultAddressRequest
+	11, // 11: address.AddressService.CheckServiceability:input_type -> address.CheckServiceabilityRequest
+	6,  // 12: address.AddressService.CreateAddress:output_type -> address.AddressResponse
+	6,  // 13: address.AddressService.GetAddress:output_type -> address.AddressResponse
+	7,  // 14: address.AddressService.ListAddresses:output_type -> address.ListAddressResponse
+	6,  // 15: address.AddressService.UpdateAddress:output_type -> address.AddressResponse
+	8,  // 16: address.AddressService.DeleteAddress:output_type -> address.DeleteAddressResponse
+	14, // 17: address.AddressService.GetAllAddresses:output_type -> address.GetAllAddressesResponse
+	6,  // 18: address.AddressService.SetDefaultAddress:output_type -> address.AddressResponse
+	6,  // 19: address.AddressService.GetDefaultAddress:output_type -> address.AddressResponse
+	12, // 20: address.AddressService.CheckServiceability:output_type -> address.CheckServiceabilityResponse
+	12, // [12:21] is the sub-list for method output_type
+	3,  // [3:12] is the sub-list for method input_type
 	3,  // [3:3] is the sub-list for extension type_name
 	3,  // [3:3] is the sub-list for extension extendee
 	0,  // [0:3] is the sub-list for field type_name
@@ -679,13 +1305,17 @@ func file_proto_address_address_proto_init() {
 	if File_proto_address_address_proto != nil {
 		return
 	}
+	file_proto_address_address_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_address_address_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_address_address_proto_msgTypes[4].OneofWrappers = []any{}
+	file_proto_address_address_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_address_address_proto_rawDesc), len(file_proto_address_address_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
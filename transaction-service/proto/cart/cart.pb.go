@@ -102,6 +102,7 @@ type CartProduct struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Qty           uint32                 `protobuf:"varint,2,opt,name=qty,proto3" json:"qty,omitempty"`
+	VariantId     uint32                 `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"` // 0 = produk tanpa variant
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -150,6 +151,13 @@ func (x *CartProduct) GetQty() uint32 {
 	return 0
 }
 
+func (x *CartProduct) GetVariantId() uint32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
 type CreateCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -591,6 +599,7 @@ type AddToCartRequest struct {
 	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Qty           uint32                 `protobuf:"varint,3,opt,name=qty,proto3" json:"qty,omitempty"`
+	VariantId     uint32                 `protobuf:"varint,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -646,11 +655,19 @@ func (x *AddToCartRequest) GetQty() uint32 {
 	return 0
 }
 
+func (x *AddToCartRequest) GetVariantId() uint32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
 type UpdateProductQtyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Qty           uint32                 `protobuf:"varint,3,opt,name=qty,proto3" json:"qty,omitempty"` //  qty == 0 → hdelete
+	VariantId     uint32                 `protobuf:"varint,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -706,10 +723,18 @@ func (x *UpdateProductQtyRequest) GetQty() uint32 {
 	return 0
 }
 
+func (x *UpdateProductQtyRequest) GetVariantId() uint32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
 type RemoveProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     uint32                 `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -758,6 +783,13 @@ func (x *RemoveProductRequest) GetProductId() uint32 {
 	return 0
 }
 
+func (x *RemoveProductRequest) GetVariantId() uint32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
 type CheckoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -813,10 +845,12 @@ const file_proto_cart_cart_proto_rawDesc = "" +
 	"\bproducts\x18\x03 \x03(\v2\x11.cart.CartProductR\bproducts\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"/\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"N\n" +
 	"\vCartProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
-	"\x03qty\x18\x02 \x01(\rR\x03qty\"]\n" +
+	"\x03qty\x18\x02 \x01(\rR\x03qty\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\rR\tvariantId\"]\n" +
 	"\x11CreateCartRequest\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12-\n" +
 	"\bproducts\x18\x02 \x03(\v2\x11.cart.CartProductR\bproducts\";\n" +
@@ -842,21 +876,27 @@ const file_proto_cart_cart_proto_rawDesc = "" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"7\n" +
 	"\x13GetAllCartsResponse\x12 \n" +
 	"\x05carts\x18\x01 \x03(\v2\n" +
-	".cart.CartR\x05carts\"^\n" +
+	".cart.CartR\x05carts\"}\n" +
 	"\x10AddToCartRequest\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\rR\tproductId\x12\x10\n" +
-	"\x03qty\x18\x03 \x01(\rR\x03qty\"e\n" +
+	"\x03qty\x18\x03 \x01(\rR\x03qty\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\rR\tvariantId\"\x84\x01\n" +
 	"\x17UpdateProductQtyRequest\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\rR\tproductId\x12\x10\n" +
-	"\x03qty\x18\x03 \x01(\rR\x03qty\"P\n" +
+	"\x03qty\x18\x03 \x01(\rR\x03qty\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\rR\tvariantId\"o\n" +
 	"\x14RemoveProductRequest\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x02 \x01(\rR\tproductId\",\n" +
+	"product_id\x18\x02 \x01(\rR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\rR\tvariantId\",\n" +
 	"\x0fCheckoutRequest\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\rR\aownerId2\xfb\x04\n" +
 	"\vCartService\x129\n" +
@@ -136,12 +136,23 @@ func (x *Transaction) GetPaidAt() string {
 }
 
 type AddressSnapshot struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AddressId     uint32                 `protobuf:"varint,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Desc          string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AddressId      uint32                 `protobuf:"varint,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Desc           string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Recipient      string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Phone          string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	StreetLine1    string                 `protobuf:"bytes,6,opt,name=street_line1,json=streetLine1,proto3" json:"street_line1,omitempty"`
+	StreetLine2    string                 `protobuf:"bytes,7,opt,name=street_line2,json=streetLine2,proto3" json:"street_line2,omitempty"`
+	City           string                 `protobuf:"bytes,8,opt,name=city,proto3" json:"city,omitempty"`
+	Province       string                 `protobuf:"bytes,9,opt,name=province,proto3" json:"province,omitempty"`
+	PostalCode     string                 `protobuf:"bytes,10,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	CountryCode    string                 `protobuf:"bytes,11,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Latitude       *float64               `protobuf:"fixed64,12,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude      *float64               `protobuf:"fixed64,13,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	AddressVersion uint32                 `protobuf:"varint,14,opt,name=address_version,json=addressVersion,proto3" json:"address_version,omitempty"` // versi alamat saat order dibuat, 0 untuk transaksi lama
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AddressSnapshot) Reset() {
@@ -195,6 +206,83 @@ func (x *AddressSnapshot) GetDesc() string {
 	return ""
 }
 
+func (x *AddressSnapshot) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *AddressSnapshot) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *AddressSnapshot) GetStreetLine1() string {
+	if x != nil {
+		return x.StreetLine1
+	}
+	return ""
+}
+
+func (x *AddressSnapshot) GetStreetLine2() string {
+	if x != nil {
+		return x.StreetLine2
+	}
+	return ""
+}
+
+func (x *AddressSnapshot) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *AddressSnapshot) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *AddressSnapshot) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *AddressSnapshot) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *AddressSnapshot) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *AddressSnapshot) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *AddressSnapshot) GetAddressVersion() uint32 {
+	if x != nil {
+		return x.AddressVersion
+	}
+	return 0
+}
+
 type ProductSnapshot struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     uint32                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -203,6 +291,9 @@ type ProductSnapshot struct {
 	Qty           uint32                 `protobuf:"varint,4,opt,name=qty,proto3" json:"qty,omitempty"`
 	Subtotal      int64                  `protobuf:"varint,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
 	CategoryId    uint32                 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	VariantId     uint32                 `protobuf:"varint,7,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"` // 0 untuk produk tanpa variant
+	Sku           string                 `protobuf:"bytes,8,opt,name=sku,proto3" json:"sku,omitempty"`
+	Options       map[string]string      `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // option variant saat order dibuat, mis. {"size": "M"}
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -279,6 +370,27 @@ func (x *ProductSnapshot) GetCategoryId() uint32 {
 	return 0
 }
 
+func (x *ProductSnapshot) GetVariantId() uint32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *ProductSnapshot) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ProductSnapshot) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
 // =====================
 //
 //	REQUESTS
@@ -288,7 +400,7 @@ type CreateTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	CartId        uint32                 `protobuf:"varint,2,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
-	AddressId     uint32                 `protobuf:"varint,3,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	AddressId     uint32                 `protobuf:"varint,3,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"` // 0 = pakai alamat default shipping user
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -776,12 +888,28 @@ const file_proto_transaction_transaction_proto_rawDesc = "" +
 	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x17\n" +
-	"\apaid_at\x18\t \x01(\tR\x06paidAt\"X\n" +
+	"\apaid_at\x18\t \x01(\tR\x06paidAt\"\xce\x03\n" +
 	"\x0fAddressSnapshot\x12\x1d\n" +
 	"\n" +
 	"address_id\x18\x01 \x01(\rR\taddressId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04desc\x18\x03 \x01(\tR\x04desc\"\xa9\x01\n" +
+	"\x04desc\x18\x03 \x01(\tR\x04desc\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12!\n" +
+	"\fstreet_line1\x18\x06 \x01(\tR\vstreetLine1\x12!\n" +
+	"\fstreet_line2\x18\a \x01(\tR\vstreetLine2\x12\x12\n" +
+	"\x04city\x18\b \x01(\tR\x04city\x12\x1a\n" +
+	"\bprovince\x18\t \x01(\tR\bprovince\x12\x1f\n" +
+	"\vpostal_code\x18\n" +
+	" \x01(\tR\n" +
+	"postalCode\x12!\n" +
+	"\fcountry_code\x18\v \x01(\tR\vcountryCode\x12\x1f\n" +
+	"\blatitude\x18\f \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\r \x01(\x01H\x01R\tlongitude\x88\x01\x01\x12'\n" +
+	"\x0faddress_version\x18\x0e \x01(\rR\x0eaddressVersionB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\xdb\x02\n" +
 	"\x0fProductSnapshot\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\rR\tproductId\x12\x12\n" +
@@ -790,7 +918,14 @@ const file_proto_transaction_transaction_proto_rawDesc = "" +
 	"\x03qty\x18\x04 \x01(\rR\x03qty\x12\x1a\n" +
 	"\bsubtotal\x18\x05 \x01(\x03R\bsubtotal\x12\x1f\n" +
 	"\vcategory_id\x18\x06 \x01(\rR\n" +
-	"categoryId\"k\n" +
+	"categoryId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\a \x01(\rR\tvariantId\x12\x10\n" +
+	"\x03sku\x18\b \x01(\tR\x03sku\x12C\n" +
+	"\aoptions\x18\t \x03(\v2).transaction.ProductSnapshot.OptionsEntryR\aoptions\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"k\n" +
 	"\x18CreateTransactionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x17\n" +
 	"\acart_id\x18\x02 \x01(\rR\x06cartId\x12\x1d\n" +
@@ -837,7 +972,7 @@ func file_proto_transaction_transaction_proto_rawDescGZIP() []byte {
 	return file_proto_transaction_transaction_proto_rawDescData
 }
 
-var file_proto_transaction_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_transaction_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_proto_transaction_transaction_proto_goTypes = []any{
 	(*Transaction)(nil),                  // 0: transaction.Transaction
 	(*AddressSnapshot)(nil),              // 1: transaction.AddressSnapshot
@@ -852,31 +987,33 @@ var file_proto_transaction_transaction_proto_goTypes = []any{
 	(*ListTransactionResponse)(nil),      // 10: transaction.ListTransactionResponse
 	(*GetAllTransactionsResponse)(nil),   // 11: transaction.GetAllTransactionsResponse
 	(*CancelTransactionResponse)(nil),    // 12: transaction.CancelTransactionResponse
-	(*emptypb.Empty)(nil),                // 13: google.protobuf.Empty
+	nil,                                  // 13: transaction.ProductSnapshot.OptionsEntry
+	(*emptypb.Empty)(nil),                // 14: google.protobuf.Empty
 }
 var file_proto_transaction_transaction_proto_depIdxs = []int32{
 	1,  // 0: transaction.Transaction.address:type_name -> transaction.AddressSnapshot
 	2,  // 1: transaction.Transaction.products:type_name -> transaction.ProductSnapshot
-	0,  // 2: transaction.TransactionResponse.transaction:type_name -> transaction.Transaction
-	0,  // 3: transaction.ListTransactionResponse.transactions:type_name -> transaction.Transaction
-	0,  // 4: transaction.GetAllTransactionsResponse.transactions:type_name -> transaction.Transaction
-	3,  // 5: transaction.TransactionService.CreateTransaction:input_type -> transaction.CreateTransactionRequest
-	5,  // 6: transaction.TransactionService.GetTransaction:input_type -> transaction.GetTransactionRequest
-	6,  // 7: transaction.TransactionService.ListUserTransactions:input_type -> transaction.ListTransactionRequest
-	13, // 8: transaction.TransactionService.ListAllTransactions:input_type -> google.protobuf.Empty
-	8,  // 9: transaction.TransactionService.CancelTransaction:input_type -> transaction.CancelTransactionRequest
-	7,  // 10: transaction.TransactionService.MarkAsPaid:input_type -> transaction.MarkAsPaidRequest
-	9,  // 11: transaction.TransactionService.CreateTransaction:output_type -> transaction.TransactionResponse
-	9,  // 12: transaction.TransactionService.GetTransaction:output_type -> transaction.TransactionResponse
-	10, // 13: transaction.TransactionService.ListUserTransactions:output_type -> transaction.ListTransactionResponse
-	10, // 14: transaction.TransactionService.ListAllTransactions:output_type -> transaction.ListTransactionResponse
-	12, // 15: transaction.TransactionService.CancelTransaction:output_type -> transaction.CancelTransactionResponse
-	9,  // 16: transaction.TransactionService.MarkAsPaid:output_type -> transaction.TransactionResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	13, // 2: transaction.ProductSnapshot.options:type_name -> transaction.ProductSnapshot.OptionsEntry
+	0,  // 3: transaction.TransactionResponse.transaction:type_name -> transaction.Transaction
+	0,  // 4: transaction.ListTransactionResponse.transactions:type_name -> transaction.Transaction
+	0,  // 5: transaction.GetAllTransactionsResponse.transactions:type_name -> transaction.Transaction
+	3,  // 6: transaction.TransactionService.CreateTransaction:input_type -> transaction.CreateTransactionRequest
+	5,  // 7: transaction.TransactionService.GetTransaction:input_type -> transaction.GetTransactionRequest
+	6,  // 8: transaction.TransactionService.ListUserTransactions:input_type -> transaction.ListTransactionRequest
+	14, // 9: transaction.TransactionService.ListAllTransactions:input_type -> google.protobuf.Empty
+	8,  // 10: transaction.TransactionService.CancelTransaction:input_type -> transaction.CancelTransactionRequest
+	7,  // 11: transaction.TransactionService.MarkAsPaid:input_type -> transaction.MarkAsPaidRequest
+	9,  // 12: transaction.TransactionService.CreateTransaction:output_type -> transaction.TransactionResponse
+	9,  // 13: transaction.TransactionService.GetTransaction:output_type -> transaction.TransactionResponse
+	10, // 14: transaction.TransactionService.ListUserTransactions:output_type -> transaction.ListTransactionResponse
+	10, // 15: transaction.TransactionService.ListAllTransactions:output_type -> transaction.ListTransactionResponse
+	12, // 16: transaction.TransactionService.CancelTransaction:output_type -> transaction.CancelTransactionResponse
+	9,  // 17: transaction.TransactionService.MarkAsPaid:output_type -> transaction.TransactionResponse
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_proto_transaction_transaction_proto_init() }
@@ -884,13 +1021,14 @@ func file_proto_transaction_transaction_proto_init() {
 	if File_proto_transaction_transaction_proto != nil {
 		return
 	}
+	file_proto_transaction_transaction_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_transaction_transaction_proto_rawDesc), len(file_proto_transaction_transaction_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"transaction-service/controller"
+	"transaction-service/middleware"
 
 	"gorm.io/gorm"
 
@@ -16,7 +17,7 @@ func RegisterTransactionRoutes(app *fiber.App,db *gorm.DB, authMiddleware fiber.
 	t.Post("/", authMiddleware, tc.Create)
 	
 	t.Get("/", authMiddleware, tc.ListUser)
-	t.Get("/all", authMiddleware, tc.ListAll)
+	t.Get("/all", authMiddleware, middleware.RequirePermission("transaction:read_all"), tc.ListAll)
 	t.Post("/:id/cancel", authMiddleware, tc.Cancel)
 	t.Get("/:id", authMiddleware, tc.Get)
 	// t.Post("/:id/pay", authMiddleware, tc.Pay)
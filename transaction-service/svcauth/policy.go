@@ -0,0 +1,16 @@
+package svcauth
+
+// ServiceName dipakai sebagai identitas service ini di service token.
+const ServiceName = "transaction-service"
+
+// ServerPolicy adalah allowlist caller untuk RPC yang di-serve service ini.
+var ServerPolicy = Policy{
+	Methods: map[string][]string{
+		"/transaction.TransactionService/GetTransaction": {ServiceName, "payment-service"},
+		// status paid hanya boleh diubah oleh payment-service
+		"/transaction.TransactionService/MarkAsPaid": {"payment-service"},
+		// export data pribadi (DSAR)
+		"/transaction.TransactionService/ListUserTransactions": {ServiceName, "user-service"},
+	},
+	Default: []string{ServiceName},
+}
@@ -23,3 +23,14 @@ func ConnectRedis() {
 
     log.Println("Redis connected (user-service)")
 }
+
+// UsersListVersionKey ikut dipakai di cache key daftar user; dinaikkan setiap
+// ada perubahan user sehingga semua variasi query (filter/sort/cursor) ikut basi.
+const UsersListVersionKey = "users:list:version"
+
+// InvalidateUsersList membuang semua cache daftar user.
+func InvalidateUsersList(ctx context.Context, rdb *redis.Client) {
+	if err := rdb.Incr(ctx, UsersListVersionKey).Err(); err != nil {
+		log.Printf("failed to invalidate users list cache: %v", err)
+	}
+}
@@ -3,9 +3,12 @@ package controller
 import (
 	"context"
 	pb "user-service/proto/user"
+	"user-service/svcauth"
 
 	"github.com/gofiber/fiber/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UserController struct {
@@ -27,17 +30,289 @@ func (uc *UserController) Me(c *fiber.Ctx) error {
 	return c.JSON(res)
 }
 
+// GetUsers: ?limit=&cursor=&role=&email_prefix=&created_from=&created_to=&sort_by=&sort_order=
 func (uc *UserController) GetUsers(c *fiber.Ctx) error {
-	res, err := uc.Client.GetUsers(context.Background(), &pb.Empty{})
+	res, err := uc.Client.GetUsers(context.Background(), &pb.GetUsersRequest{
+		Limit:       uint32(c.QueryInt("limit", 0)),
+		Cursor:      c.Query("cursor"),
+		Role:        c.Query("role"),
+		EmailPrefix: c.Query("email_prefix"),
+		CreatedFrom: c.Query("created_from"),
+		CreatedTo:   c.Query("created_to"),
+		SortBy:      c.Query("sort_by"),
+		SortOrder:   c.Query("sort_order"),
+	})
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return c.Status(400).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
 		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
 	}
 
-	return c.JSON(res.Users)
+	users := res.Users
+	if users == nil {
+		users = []*pb.UserResponse{}
+	}
+	return c.JSON(fiber.Map{
+		"users":       users,
+		"total":       res.Total,
+		"next_cursor": res.NextCursor,
+	})
+}
+
+type updateMeInput struct {
+	Phone       *string `json:"phone"`
+	AvatarURL   *string `json:"avatar_url"`
+	DateOfBirth *string `json:"date_of_birth"`
+	Locale      *string `json:"locale"`
+}
+
+// UpdateMe hanya mengubah field yang ada di body.
+func (uc *UserController) UpdateMe(c *fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uint32)
+	if !ok {
+		return c.Status(401).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	var body updateMeInput
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := uc.Client.UpdateMe(context.Background(), &pb.UpdateMeRequest{
+		UserId:      userID,
+		Phone:       body.Phone,
+		AvatarUrl:   body.AvatarURL,
+		DateOfBirth: body.DateOfBirth,
+		Locale:      body.Locale,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return c.Status(400).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		case codes.NotFound:
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		case codes.FailedPrecondition:
+			return c.Status(409).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(res)
+}
+
+// DeleteMe menonaktifkan akun dan memulai penghapusan data di semua service.
+func (uc *UserController) DeleteMe(c *fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uint32)
+	if !ok {
+		return c.Status(401).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	// penghapusan akun harus dilakukan user sendiri, bukan lewat API key
+	if keyID, _ := c.Locals("api_key_id").(uint32); keyID != 0 {
+		return c.Status(403).JSON(fiber.Map{"error": "account deletion is not allowed with an API key"})
+	}
+
+	res, err := uc.Client.DeleteMe(context.Background(), &pb.DeleteMeRequest{UserId: userID})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		case codes.FailedPrecondition:
+			return c.Status(409).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.Status(202).JSON(res)
+}
+
+// ErasureReport menampilkan status penghapusan data per service untuk user :id.
+func (uc *UserController) ErasureReport(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid user id"})
+	}
+
+	res, err := uc.Client.GetErasureReport(context.Background(), &pb.GetErasureReportRequest{UserId: uint32(id)})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(res)
+}
+
+// RequestExport memulai export data pribadi. Tanpa :id berarti export milik sendiri.
+func (uc *UserController) RequestExport(c *fiber.Ctx) error {
+	requesterID, ok := c.Locals("user_id").(uint32)
+	if !ok {
+		return c.Status(401).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	userID := requesterID
+	if c.Params("id") != "" {
+		id, err := c.ParamsInt("id")
+		if err != nil || id <= 0 {
+			return c.Status(400).JSON(fiber.Map{"error": "invalid user id"})
+		}
+		userID = uint32(id)
+	}
+
+	res, err := uc.Client.RequestDataExport(context.Background(), &pb.DataExportRequest{
+		UserId:      userID,
+		RequestedBy: requesterID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.Status(202).JSON(res)
+}
+
+// GetExport dipakai untuk polling status export.
+func (uc *UserController) GetExport(c *fiber.Ctx) error {
+	res, err := uc.Client.GetDataExport(context.Background(), exportRequest(c))
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(res)
+}
+
+// DownloadExport mengirim archive JSON sebagai file attachment.
+func (uc *UserController) DownloadExport(c *fiber.Ctx) error {
+	res, err := uc.Client.DownloadDataExport(context.Background(), exportRequest(c))
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return c.Status(404).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		case codes.FailedPrecondition:
+			return c.Status(409).JSON(fiber.Map{"error": status.Convert(err).Message()})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	c.Attachment(res.Filename)
+	return c.Send(res.Content)
+}
+
+// SuspendUser (admin) men-suspend akun user :id, body {"reason": "..."}.
+func (uc *UserController) SuspendUser(c *fiber.Ctx) error {
+	return uc.changeAccountStatus(c, uc.Client.SuspendUser)
+}
+
+// ReactivateUser (admin) mengaktifkan kembali akun user :id, body {"reason": "..."}.
+func (uc *UserController) ReactivateUser(c *fiber.Ctx) error {
+	return uc.changeAccountStatus(c, uc.Client.ReactivateUser)
+}
+
+func (uc *UserController) changeAccountStatus(c *fiber.Ctx, call func(context.Context, *pb.AccountStatusRequest, ...grpc.CallOption) (*pb.UserResponse, error)) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid user id"})
+	}
+	actorID, _ := c.Locals("user_id").(uint32)
+
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := call(context.Background(), &pb.AccountStatusRequest{
+		UserId:  uint32(id),
+		ActorId: actorID,
+		Reason:  body.Reason,
+	})
+	if err != nil {
+		return adminError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// Impersonate (support) mengembalikan token read-only berumur pendek untuk user :id.
+func (uc *UserController) Impersonate(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid user id"})
+	}
+	actorID, _ := c.Locals("user_id").(uint32)
+
+	// token impersonation harus bisa ditelusuri ke staf yang memintanya
+	if keyID, _ := c.Locals("api_key_id").(uint32); keyID != 0 {
+		return c.Status(403).JSON(fiber.Map{"error": "impersonation is not allowed with an API key"})
+	}
+
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := uc.Client.ImpersonateUser(context.Background(), &pb.ImpersonateRequest{
+		UserId:  uint32(id),
+		ActorId: actorID,
+		Reason:  body.Reason,
+	})
+	if err != nil {
+		return adminError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func adminError(c *fiber.Ctx, err error) error {
+	msg := status.Convert(err).Message()
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return c.Status(400).JSON(fiber.Map{"error": msg})
+	case codes.PermissionDenied:
+		return c.Status(403).JSON(fiber.Map{"error": msg})
+	case codes.NotFound:
+		return c.Status(404).JSON(fiber.Map{"error": msg})
+	case codes.FailedPrecondition:
+		return c.Status(409).JSON(fiber.Map{"error": msg})
+	case codes.Unavailable:
+		return c.Status(503).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+}
+
+// exportRequest: admin (user:read_all) boleh melihat export milik user lain.
+func exportRequest(c *fiber.Ctx) *pb.GetDataExportRequest {
+	requesterID, _ := c.Locals("user_id").(uint32)
+	perms, _ := c.Locals("permissions").([]string)
+
+	anyUser := false
+	for _, p := range perms {
+		if p == "user:read_all" {
+			anyUser = true
+			break
+		}
+	}
+
+	return &pb.GetDataExportRequest{
+		JobId:       c.Params("job_id"),
+		RequesterId: requesterID,
+		AnyUser:     anyUser,
+	}
 }
 
 func NewUserController() *UserController {
-	conn, err := grpc.Dial("localhost:50051", grpc.WithInsecure()) // port gRPC user
+	conn, err := grpc.Dial("localhost:50051", grpc.WithInsecure(), svcauth.DialOption()) // port gRPC user
 	if err != nil {
 		panic("failed to connect to user gRPC: " + err.Error())
 	}
@@ -0,0 +1,37 @@
+package grpc_client
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "user-service/proto/address"
+	"user-service/svcauth"
+
+	"google.golang.org/grpc"
+)
+
+type AddressClient struct {
+	client pb.AddressServiceClient
+}
+
+func NewAddressClient() *AddressClient {
+	conn, err := grpc.Dial("address-service:50053", grpc.WithInsecure(), svcauth.DialOption())
+	if err != nil {
+		log.Fatalf("could not connect to address-service: %v", err)
+	}
+
+	c := pb.NewAddressServiceClient(conn)
+	return &AddressClient{client: c}
+}
+
+func (c *AddressClient) ListAddresses(userID uint32) ([]*pb.Address, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.client.ListAddresses(ctx, &pb.ListAddressRequest{OwnerId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetAddresses(), nil
+}
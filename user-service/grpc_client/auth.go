@@ -0,0 +1,67 @@
+package grpc_client
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "user-service/proto/auth"
+	"user-service/svcauth"
+
+	"google.golang.org/grpc"
+)
+
+type AuthClient struct {
+	client pb.AuthServiceClient
+}
+
+func NewAuthClient() *AuthClient {
+	conn, err := grpc.Dial("auth-service:50052", grpc.WithInsecure(), svcauth.DialOption())
+	if err != nil {
+		log.Fatalf("could not connect to auth-service: %v", err)
+	}
+
+	c := pb.NewAuthServiceClient(conn)
+	return &AuthClient{client: c}
+}
+
+// ListUsers mengambil satu halaman user dari auth-service, urut berdasarkan id.
+func (c *AuthClient) ListUsers(afterID uint32, limit uint32) ([]*pb.AuthResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	res, err := c.client.ListUsers(ctx, &pb.ListUsersRequest{AfterId: afterID, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetUsers(), nil
+}
+
+// SetSuspended men-suspend (suspend=true) atau mengaktifkan kembali akun user di auth-service.
+func (c *AuthClient) SetSuspended(userID, actorID uint32, reason string, suspend bool) (*pb.AuthResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &pb.AccountStatusRequest{UserId: userID, ActorId: actorID, Reason: reason}
+	var (
+		res *pb.AccountStatusResponse
+		err error
+	)
+	if suspend {
+		res, err = c.client.SuspendUser(ctx, req)
+	} else {
+		res, err = c.client.ReactivateUser(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.GetUser(), nil
+}
+
+// Impersonate meminta token impersonation untuk user dari auth-service.
+func (c *AuthClient) Impersonate(userID, actorID uint32, reason string) (*pb.ImpersonateResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return c.client.Impersonate(ctx, &pb.ImpersonateRequest{UserId: userID, ActorId: actorID, Reason: reason})
+}
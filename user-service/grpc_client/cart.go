@@ -0,0 +1,37 @@
+package grpc_client
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "user-service/proto/cart"
+	"user-service/svcauth"
+
+	"google.golang.org/grpc"
+)
+
+type CartClient struct {
+	client pb.CartServiceClient
+}
+
+func NewCartClient() *CartClient {
+	conn, err := grpc.Dial("cart-service:50055", grpc.WithInsecure(), svcauth.DialOption())
+	if err != nil {
+		log.Fatalf("could not connect to cart-service: %v", err)
+	}
+
+	c := pb.NewCartServiceClient(conn)
+	return &CartClient{client: c}
+}
+
+func (c *CartClient) ListCarts(userID uint32) ([]*pb.Cart, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.client.ListCarts(ctx, &pb.ListCartRequest{OwnerId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetCarts(), nil
+}
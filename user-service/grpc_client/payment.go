@@ -0,0 +1,37 @@
+package grpc_client
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "user-service/proto/payment"
+	"user-service/svcauth"
+
+	"google.golang.org/grpc"
+)
+
+type PaymentClient struct {
+	client pb.PaymentServiceClient
+}
+
+func NewPaymentClient() *PaymentClient {
+	conn, err := grpc.Dial("payment-service:50057", grpc.WithInsecure(), svcauth.DialOption())
+	if err != nil {
+		log.Fatalf("could not connect to payment-service: %v", err)
+	}
+
+	c := pb.NewPaymentServiceClient(conn)
+	return &PaymentClient{client: c}
+}
+
+func (c *PaymentClient) ListUserPayments(userID uint32) ([]*pb.Payment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.client.ListUserPayments(ctx, &pb.ListPaymentRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetPayments(), nil
+}
@@ -0,0 +1,37 @@
+package grpc_client
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "user-service/proto/transaction"
+	"user-service/svcauth"
+
+	"google.golang.org/grpc"
+)
+
+type TransactionClient struct {
+	client pb.TransactionServiceClient
+}
+
+func NewTransactionClient() *TransactionClient {
+	conn, err := grpc.Dial("transaction-service:50056", grpc.WithInsecure(), svcauth.DialOption())
+	if err != nil {
+		log.Fatalf("could not connect to transaction-service: %v", err)
+	}
+
+	c := pb.NewTransactionServiceClient(conn)
+	return &TransactionClient{client: c}
+}
+
+func (c *TransactionClient) ListUserTransactions(userID uint32) ([]*pb.Transaction, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.client.ListUserTransactions(ctx, &pb.ListTransactionRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetTransactions(), nil
+}
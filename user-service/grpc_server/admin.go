@@ -0,0 +1,82 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"user-service/kafka"
+	"user-service/model"
+	pb "user-service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// SuspendUser (admin) men-suspend akun lewat auth-service, lalu langsung menyalin statusnya
+// ke DB lokal tanpa menunggu event user.updated.
+func (s *UserServer) SuspendUser(ctx context.Context, in *pb.AccountStatusRequest) (*pb.UserResponse, error) {
+	return s.setSuspended(in, true)
+}
+
+// ReactivateUser (admin) membatalkan suspend.
+func (s *UserServer) ReactivateUser(ctx context.Context, in *pb.AccountStatusRequest) (*pb.UserResponse, error) {
+	return s.setSuspended(in, false)
+}
+
+func (s *UserServer) setSuspended(in *pb.AccountStatusRequest, suspend bool) (*pb.UserResponse, error) {
+	user, err := s.loadActiveUser(in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// error auth-service (reason kosong, sudah di-suspend, dst.) diteruskan apa adanya
+	res, err := s.Auth.SetSuspended(in.UserId, in.ActorId, in.Reason, suspend)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := kafka.SyncSuspension(s.DB, user.ID, res.Suspended, res.SuspendedReason); err != nil {
+		// auth-service sudah berubah; event user.updated akan menyusulkan data lokal
+		log.Printf("failed to sync suspension for user %d: %v", user.ID, err)
+	}
+	if err := s.DB.First(user, user.ID).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load user")
+	}
+	return toUserResponse(user), nil
+}
+
+// ImpersonateUser (support) meminta token read-only untuk melihat storefront sebagai user.
+func (s *UserServer) ImpersonateUser(ctx context.Context, in *pb.ImpersonateRequest) (*pb.ImpersonateResponse, error) {
+	user, err := s.loadActiveUser(in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.Auth.Impersonate(in.UserId, in.ActorId, in.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("user %d impersonated by %d", user.ID, in.ActorId)
+	return &pb.ImpersonateResponse{
+		AccessToken: res.AccessToken,
+		TokenType:   res.TokenType,
+		ExpiresIn:   res.ExpiresIn,
+		User:        toUserResponse(user),
+	}, nil
+}
+
+func (s *UserServer) loadActiveUser(id uint32) (*model.User, error) {
+	var user model.User
+	if err := s.DB.First(&user, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load user")
+	}
+	if user.DeactivatedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "account has been deleted")
+	}
+	return &user, nil
+}
@@ -0,0 +1,150 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+	"user-service/cache"
+	"user-service/kafka"
+	"user-service/model"
+	"user-service/outbox"
+	pb "user-service/proto/user"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// =========================================================
+// DELETE ME
+// =========================================================
+// User dinonaktifkan dan data profilnya dianonimkan di sini; service lain
+// menghapus datanya sendiri setelah menerima event user.deleted lalu melapor
+// lewat user.erasure_completed.
+func (s *UserServer) DeleteMe(ctx context.Context, in *pb.DeleteMeRequest) (*pb.DeleteMeResponse, error) {
+	if in.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	var user model.User
+	if err := s.DB.First(&user, in.UserId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load user")
+	}
+	if user.DeactivatedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "account already deleted")
+	}
+
+	now := time.Now()
+	req := model.ErasureRequest{
+		RequestID:   uuid.NewString(),
+		UserID:      user.ID,
+		RequestedAt: now,
+	}
+
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&user).Updates(map[string]interface{}{
+			"email":          fmt.Sprintf("deleted-%d@deleted.invalid", user.ID),
+			"name":           "Deleted User",
+			"password":       "",
+			"phone":          "",
+			"avatar_url":     "",
+			"date_of_birth":  nil,
+			"locale":         "",
+			"deactivated_at": now,
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&req).Error; err != nil {
+			return err
+		}
+		// user.deleted lewat outbox: kalau Kafka sedang mati event tetap terkirim belakangan,
+		// jadi service lain pasti ikut menghapus datanya
+		return outbox.Enqueue(tx, "user.deleted", "user_deleted", strconv.FormatUint(uint64(user.ID), 10), map[string]interface{}{
+			"user_id":      user.ID,
+			"request_id":   req.RequestID,
+			"requested_at": now.UTC().Format(time.RFC3339),
+		})
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete account")
+	}
+
+	s.Redis.Del(ctx,
+		fmt.Sprintf("user:%d", user.ID),
+		fmt.Sprintf("user:me:%d", user.ID),
+	)
+	cache.InvalidateUsersList(ctx, s.Redis)
+
+	if err := kafka.RecordErasureReport(s.DB, &model.ErasureReport{
+		RequestID:   req.RequestID,
+		Service:     kafka.Source,
+		UserID:      user.ID,
+		Status:      "completed",
+		Details:     map[string]int64{"users_anonymized": 1},
+		CompletedAt: time.Now(),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record erasure report")
+	}
+
+	return &pb.DeleteMeResponse{
+		Message:   "account deleted",
+		RequestId: req.RequestID,
+	}, nil
+}
+
+// =========================================================
+// GET ERASURE REPORT
+// =========================================================
+// Service yang belum melapor ditampilkan dengan status pending.
+func (s *UserServer) GetErasureReport(ctx context.Context, in *pb.GetErasureReportRequest) (*pb.ErasureReportResponse, error) {
+	var req model.ErasureRequest
+	if err := s.DB.Where("user_id = ?", in.UserId).Order("requested_at DESC").First(&req).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no erasure request for this user")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load erasure request")
+	}
+
+	var reports []model.ErasureReport
+	if err := s.DB.Where("request_id = ?", req.RequestID).Find(&reports).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load erasure reports")
+	}
+	byService := make(map[string]model.ErasureReport, len(reports))
+	for _, r := range reports {
+		byService[r.Service] = r
+	}
+
+	resp := &pb.ErasureReportResponse{
+		RequestId:   req.RequestID,
+		UserId:      uint32(req.UserID),
+		Status:      "pending",
+		RequestedAt: req.RequestedAt.UTC().Format(time.RFC3339),
+	}
+	if req.CompletedAt != nil {
+		resp.Status = "completed"
+		resp.CompletedAt = req.CompletedAt.UTC().Format(time.RFC3339)
+	}
+
+	for _, svc := range model.ErasureServices {
+		r, ok := byService[svc]
+		if !ok {
+			resp.Services = append(resp.Services, &pb.ServiceErasure{Service: svc, Status: "pending"})
+			continue
+		}
+		resp.Services = append(resp.Services, &pb.ServiceErasure{
+			Service:     svc,
+			Status:      r.Status,
+			Details:     r.Details,
+			Error:       r.Error,
+			CompletedAt: r.CompletedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,256 @@
+package grpc_server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+	"user-service/model"
+	pb "user-service/proto/user"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	exportPending   = "pending"
+	exportRunning   = "running"
+	exportCompleted = "completed"
+	exportFailed    = "failed"
+
+	exportTimeout = 2 * time.Minute
+)
+
+// =========================================================
+// REQUEST DATA EXPORT
+// =========================================================
+// Export dijalankan di background; client mem-polling GetDataExport sampai
+// status completed lalu mengunduh archive lewat DownloadDataExport.
+func (s *UserServer) RequestDataExport(ctx context.Context, in *pb.DataExportRequest) (*pb.DataExportJob, error) {
+	if in.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	var user model.User
+	if err := s.DB.First(&user, in.UserId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load user")
+	}
+
+	// satu user hanya boleh punya satu export yang sedang berjalan
+	var existing model.ExportJob
+	err := s.DB.Where("user_id = ? AND status IN ?", in.UserId, []string{exportPending, exportRunning}).
+		First(&existing).Error
+	if err == nil {
+		return toExportJob(&existing), nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to load export jobs")
+	}
+
+	job := model.ExportJob{
+		JobID:       uuid.NewString(),
+		UserID:      user.ID,
+		RequestedBy: uint(in.RequestedBy),
+		Status:      exportPending,
+	}
+	if err := s.DB.Create(&job).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create export job")
+	}
+
+	go s.runExport(job.JobID)
+
+	return toExportJob(&job), nil
+}
+
+// =========================================================
+// GET DATA EXPORT (status polling)
+// =========================================================
+func (s *UserServer) GetDataExport(ctx context.Context, in *pb.GetDataExportRequest) (*pb.DataExportJob, error) {
+	job, err := s.findExportJob(in)
+	if err != nil {
+		return nil, err
+	}
+	return toExportJob(job), nil
+}
+
+// =========================================================
+// DOWNLOAD DATA EXPORT
+// =========================================================
+func (s *UserServer) DownloadDataExport(ctx context.Context, in *pb.GetDataExportRequest) (*pb.DataExportArchive, error) {
+	job, err := s.findExportJob(in)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != exportCompleted {
+		return nil, status.Errorf(codes.FailedPrecondition, "export is %s", job.Status)
+	}
+	if (job.ExpiresAt != nil && time.Now().After(*job.ExpiresAt)) || len(job.Archive) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "export has expired, please request a new one")
+	}
+
+	return &pb.DataExportArchive{
+		JobId:    job.JobID,
+		Filename: fmt.Sprintf("user-%d-export-%s.json", job.UserID, job.CreatedAt.UTC().Format("20060102")),
+		Content:  job.Archive,
+	}, nil
+}
+
+// PurgeExpiredExports menghapus archive yang sudah melewati masa berlaku secara berkala.
+// Metadata job tetap disimpan sebagai catatan bahwa export pernah dibuat.
+func (s *UserServer) PurgeExpiredExports(ctx context.Context, every time.Duration) {
+	go func() {
+		ticker := time.NewTicker(every)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				res := s.DB.Model(&model.ExportJob{}).
+					Where("expires_at < ? AND archive IS NOT NULL", time.Now()).
+					Update("archive", nil)
+				if res.Error != nil {
+					log.Printf("failed to purge expired exports: %v", res.Error)
+				} else if res.RowsAffected > 0 {
+					log.Printf("purged %d expired data exports", res.RowsAffected)
+				}
+			}
+		}
+	}()
+}
+
+// FailInterruptedExports dipanggil saat start: job yang masih pending/running
+// berarti prosesnya ikut mati saat restart, jadi ditandai failed supaya user bisa request ulang.
+func (s *UserServer) FailInterruptedExports() {
+	res := s.DB.Model(&model.ExportJob{}).
+		Where("status IN ?", []string{exportPending, exportRunning}).
+		Updates(map[string]interface{}{
+			"status":       exportFailed,
+			"error":        "export interrupted, please request a new one",
+			"completed_at": time.Now(),
+		})
+	if res.Error != nil {
+		log.Printf("failed to reset interrupted exports: %v", res.Error)
+	}
+}
+
+// ====================== HELPER ======================
+
+// findExportJob hanya mengembalikan job milik requester, kecuali requester admin.
+// Job milik user lain dilaporkan NotFound supaya job_id tidak bisa ditebak.
+func (s *UserServer) findExportJob(in *pb.GetDataExportRequest) (*model.ExportJob, error) {
+	var job model.ExportJob
+	if err := s.DB.Where("job_id = ?", in.JobId).First(&job).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "export not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load export job")
+	}
+	if !in.AnyUser && job.UserID != uint(in.RequesterId) && job.RequestedBy != uint(in.RequesterId) {
+		return nil, status.Errorf(codes.NotFound, "export not found")
+	}
+	return &job, nil
+}
+
+// runExport mengumpulkan data user dari semua service dan menyimpannya sebagai satu archive JSON.
+func (s *UserServer) runExport(jobID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	var job model.ExportJob
+	if err := s.DB.WithContext(ctx).Where("job_id = ?", jobID).First(&job).Error; err != nil {
+		log.Printf("export %s: failed to load job: %v", jobID, err)
+		return
+	}
+	s.DB.WithContext(ctx).Model(&job).Update("status", exportRunning)
+
+	archive, err := s.buildExportArchive(ctx, job.UserID)
+	now := time.Now()
+	if err != nil {
+		log.Printf("export %s failed: %v", jobID, err)
+		s.DB.Model(&job).Updates(map[string]interface{}{
+			"status":       exportFailed,
+			"error":        err.Error(),
+			"completed_at": now,
+		})
+		return
+	}
+
+	expires := now.Add(s.ExportTTL)
+	if err := s.DB.Model(&job).Updates(map[string]interface{}{
+		"status":       exportCompleted,
+		"archive":      archive,
+		"completed_at": now,
+		"expires_at":   expires,
+	}).Error; err != nil {
+		log.Printf("export %s: failed to save archive: %v", jobID, err)
+		return
+	}
+
+	log.Printf("export %s completed for user %d (%d bytes)", jobID, job.UserID, len(archive))
+}
+
+func (s *UserServer) buildExportArchive(ctx context.Context, userID uint) ([]byte, error) {
+	var user model.User
+	if err := s.DB.WithContext(ctx).First(&user, userID).Error; err != nil {
+		return nil, fmt.Errorf("user-service: %w", err)
+	}
+
+	var erasures []model.ErasureRequest
+	if err := s.DB.WithContext(ctx).Where("user_id = ?", userID).Find(&erasures).Error; err != nil {
+		return nil, fmt.Errorf("user-service: %w", err)
+	}
+
+	id := uint32(userID)
+	addresses, err := s.Addresses.ListAddresses(id)
+	if err != nil {
+		return nil, fmt.Errorf("address-service: %w", err)
+	}
+	carts, err := s.Carts.ListCarts(id)
+	if err != nil {
+		return nil, fmt.Errorf("cart-service: %w", err)
+	}
+	transactions, err := s.Transactions.ListUserTransactions(id)
+	if err != nil {
+		return nil, fmt.Errorf("transaction-service: %w", err)
+	}
+	payments, err := s.Payments.ListUserPayments(id)
+	if err != nil {
+		return nil, fmt.Errorf("payment-service: %w", err)
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"generated_at":     time.Now().UTC().Format(time.RFC3339),
+		"user":             user,
+		"erasure_requests": erasures,
+		"addresses":        addresses,
+		"carts":            carts,
+		"transactions":     transactions,
+		"payments":         payments,
+	}, "", "  ")
+}
+
+func toExportJob(job *model.ExportJob) *pb.DataExportJob {
+	resp := &pb.DataExportJob{
+		JobId:       job.JobID,
+		UserId:      uint32(job.UserID),
+		RequestedBy: uint32(job.RequestedBy),
+		Status:      job.Status,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if job.CompletedAt != nil {
+		resp.CompletedAt = job.CompletedAt.UTC().Format(time.RFC3339)
+	}
+	if job.ExpiresAt != nil {
+		resp.ExpiresAt = job.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return resp
+}
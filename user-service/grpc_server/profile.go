@@ -0,0 +1,149 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"user-service/cache"
+	"user-service/kafka"
+	"user-service/model"
+	pb "user-service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const dateLayout = "2006-01-02"
+
+var (
+	phonePattern  = regexp.MustCompile(`^\+?[0-9]{7,15}$`)
+	localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+)
+
+// =========================================================
+// UPDATE ME
+// =========================================================
+// Email, nama dan role dikelola auth-service, jadi tidak bisa diubah lewat sini.
+func (s *UserServer) UpdateMe(ctx context.Context, in *pb.UpdateMeRequest) (*pb.UserResponse, error) {
+	if in.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	updates := map[string]interface{}{}
+
+	if in.Phone != nil {
+		phone := strings.TrimSpace(in.GetPhone())
+		if phone != "" && !phonePattern.MatchString(phone) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid phone number")
+		}
+		updates["phone"] = phone
+	}
+
+	if in.AvatarUrl != nil {
+		avatar := strings.TrimSpace(in.GetAvatarUrl())
+		if avatar != "" {
+			u, err := url.Parse(avatar)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "avatar_url must be an http(s) URL")
+			}
+		}
+		updates["avatar_url"] = avatar
+	}
+
+	if in.DateOfBirth != nil {
+		dob := strings.TrimSpace(in.GetDateOfBirth())
+		if dob == "" {
+			updates["date_of_birth"] = nil
+		} else {
+			t, err := time.Parse(dateLayout, dob)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "date_of_birth must be YYYY-MM-DD")
+			}
+			if t.After(time.Now()) {
+				return nil, status.Errorf(codes.InvalidArgument, "date_of_birth cannot be in the future")
+			}
+			updates["date_of_birth"] = t
+		}
+	}
+
+	if in.Locale != nil {
+		locale := strings.TrimSpace(in.GetLocale())
+		if locale != "" && !localePattern.MatchString(locale) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid locale, expected e.g. id or en-US")
+		}
+		updates["locale"] = locale
+	}
+
+	var user model.User
+	if err := s.DB.First(&user, in.UserId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load user")
+	}
+	if user.DeactivatedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "account has been deleted")
+	}
+
+	if len(updates) > 0 {
+		if err := s.DB.Model(&user).Updates(updates).Error; err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update profile")
+		}
+		if err := s.DB.First(&user, in.UserId).Error; err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load user")
+		}
+
+		s.Redis.Del(ctx,
+			fmt.Sprintf("user:%d", user.ID),
+			fmt.Sprintf("user:me:%d", user.ID),
+		)
+		cache.InvalidateUsersList(ctx, s.Redis)
+
+		kafka.PublishUserUpdatedEvent(userEventData(&user))
+	}
+
+	return toUserResponse(&user), nil
+}
+
+// ====================== HELPER ======================
+
+func toUserResponse(user *model.User) *pb.UserResponse {
+	resp := &pb.UserResponse{
+		Id:        uint32(user.ID),
+		Email:     user.Email,
+		Name:      user.Name,
+		Role:      user.Role,
+		Phone:     user.Phone,
+		AvatarUrl: user.AvatarURL,
+		Locale:    user.Locale,
+		CreatedAt: user.CreatedAt.UTC().Format(time.RFC3339),
+
+		Suspended:       user.SuspendedAt != nil,
+		SuspendedReason: user.SuspendedReason,
+	}
+	if user.DateOfBirth != nil {
+		resp.DateOfBirth = user.DateOfBirth.Format(dateLayout)
+	}
+	return resp
+}
+
+// userEventData sengaja tidak memakai pb.UserResponse: tag omitempty-nya akan
+// membuang field yang dikosongkan, sehingga consumer tidak ikut menghapusnya.
+func userEventData(user *model.User) map[string]interface{} {
+	resp := toUserResponse(user)
+	return map[string]interface{}{
+		"id":            resp.Id,
+		"email":         resp.Email,
+		"name":          resp.Name,
+		"role":          resp.Role,
+		"phone":         resp.Phone,
+		"avatar_url":    resp.AvatarUrl,
+		"date_of_birth": resp.DateOfBirth,
+		"locale":        resp.Locale,
+	}
+}
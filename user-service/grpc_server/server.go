@@ -5,41 +5,42 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+	"user-service/cache"
+	"user-service/grpc_client"
+	"user-service/jwks"
 	"user-service/model"
 	pb "user-service/proto/user"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
 type UserServer struct {
 	pb.UnimplementedUserServiceServer
-	DB        *gorm.DB
-	JWTSecret string
-	Redis     *redis.Client
+	DB       *gorm.DB
+	Verifier *jwks.Verifier
+	Redis    *redis.Client
+	// suspend / impersonate diteruskan ke auth-service
+	Auth *grpc_client.AuthClient
+	// sumber data untuk export data pribadi
+	Addresses    *grpc_client.AddressClient
+	Carts        *grpc_client.CartClient
+	Transactions *grpc_client.TransactionClient
+	Payments     *grpc_client.PaymentClient
+	ExportTTL    time.Duration
 }
 
 // =========================================================
 // GET ME (with Redis cache)
 // =========================================================
 func (s *UserServer) GetMe(ctx context.Context, in *pb.GetMeRequest) (*pb.UserResponse, error) {
-	token, err := jwt.Parse(in.Token, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.JWTSecret), nil
-	})
-	if err != nil || !token.Valid {
+	claims, err := s.Verifier.Verify(in.Token)
+	if err != nil {
 		return nil, fmt.Errorf("invalid token")
 	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, fmt.Errorf("invalid claims")
-	}
-
-	sub, ok := claims["sub"].(float64)
-	if !ok {
-		return nil, fmt.Errorf("invalid sub claim")
-	}
+	sub := claims.UserID
 
 	cacheKey := fmt.Sprintf("user:me:%d", uint(sub))
 
@@ -59,12 +60,7 @@ func (s *UserServer) GetMe(ctx context.Context, in *pb.GetMeRequest) (*pb.UserRe
 		return nil, err
 	}
 
-	resp := &pb.UserResponse{
-		Id:    uint32(user.ID),
-		Email: user.Email,
-		Name:  user.Name,
-		Role:  user.Role,
-	}
+	resp := toUserResponse(&user)
 
 	// Save to Redis (TTL 5 minutes)
 	jsonData, _ := json.Marshal(resp)
@@ -96,12 +92,7 @@ func (s *UserServer) GetUserInfo(ctx context.Context, in *pb.GetUserRequest) (*p
 		return nil, err
 	}
 
-	resp := &pb.UserResponse{
-		Id:    uint32(user.ID),
-		Email: user.Email,
-		Name:  user.Name,
-		Role:  user.Role,
-	}
+	resp := toUserResponse(&user)
 
 	// Save to Redis (TTL 5 min)
 	jsonData, _ := json.Marshal(resp)
@@ -111,11 +102,17 @@ func (s *UserServer) GetUserInfo(ctx context.Context, in *pb.GetUserRequest) (*p
 }
 
 // =========================================================
-// GET USERS (cache all list)
+// GET USERS (cursor pagination, cache per bentuk query)
 // =========================================================
-func (s *UserServer) GetUsers(ctx context.Context, _ *pb.Empty) (*pb.UsersResponse, error) {
+func (s *UserServer) GetUsers(ctx context.Context, in *pb.GetUsersRequest) (*pb.UsersResponse, error) {
+	q, err := parseUsersQuery(in)
+	if err != nil {
+		return nil, err
+	}
 
-	cacheKey := "users:all"
+	// versi dinaikkan setiap ada perubahan user, jadi semua key lama otomatis basi
+	version, _ := s.Redis.Get(ctx, cache.UsersListVersionKey).Int64()
+	cacheKey := fmt.Sprintf("users:list:v%d:%s", version, q.cacheKey())
 
 	// ---- Redis HIT ----
 	cached, err := s.Redis.Get(ctx, cacheKey).Result()
@@ -128,20 +125,30 @@ func (s *UserServer) GetUsers(ctx context.Context, _ *pb.Empty) (*pb.UsersRespon
 
 	fmt.Println("❄ Redis MISS → DB (GetUsers)")
 
+	base := q.filter(s.DB.WithContext(ctx).Model(&model.User{}))
+
+	var total int64
+	if err := base.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count users")
+	}
+
+	// ambil satu baris lebih untuk tahu apakah masih ada halaman berikutnya
 	var users []model.User
-	if err := s.DB.Select("id", "email", "name", "role").Find(&users).Error; err != nil {
-		return nil, err
+	if err := q.page(base.Session(&gorm.Session{})).
+		Select("id", "email", "name", "role", "phone", "avatar_url", "date_of_birth", "locale", "created_at", "suspended_at", "suspended_reason").
+		Limit(q.limit + 1).
+		Find(&users).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users")
 	}
 
-	resp := &pb.UsersResponse{}
+	resp := &pb.UsersResponse{Total: total}
+	if len(users) > q.limit {
+		users = users[:q.limit]
+		resp.NextCursor = q.nextCursor(&users[len(users)-1])
+	}
 
-	for _, u := range users {
-		resp.Users = append(resp.Users, &pb.UserResponse{
-			Id:    uint32(u.ID),
-			Email: u.Email,
-			Name:  u.Name,
-			Role:  u.Role,
-		})
+	for i := range users {
+		resp.Users = append(resp.Users, toUserResponse(&users[i]))
 	}
 
 	// Cache (TTL 30 seconds)
@@ -0,0 +1,215 @@
+package grpc_server
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"user-service/model"
+	pb "user-service/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultUsersLimit = 50
+	maxUsersLimit     = 200
+)
+
+// kolom yang boleh dipakai untuk sort (nama kolom langsung masuk ke SQL, jadi harus whitelist)
+var userSortColumns = map[string]bool{
+	"id":         true,
+	"email":      true,
+	"name":       true,
+	"created_at": true,
+}
+
+// usersQuery adalah GetUsersRequest yang sudah divalidasi dan dinormalisasi.
+type usersQuery struct {
+	limit       int
+	role        string
+	emailPrefix string
+	createdFrom *time.Time
+	createdTo   *time.Time // eksklusif
+	sortBy      string
+	desc        bool
+	after       *usersCursor
+}
+
+// usersCursor menyimpan posisi baris terakhir halaman sebelumnya (keyset pagination).
+// Sort ikut disimpan supaya cursor tidak dipakai dengan urutan yang berbeda.
+type usersCursor struct {
+	SortBy string `json:"s"`
+	Desc   bool   `json:"d"`
+	Value  string `json:"v,omitempty"`
+	ID     uint   `json:"id"`
+}
+
+func parseUsersQuery(in *pb.GetUsersRequest) (*usersQuery, error) {
+	q := &usersQuery{
+		limit:       int(in.Limit),
+		role:        strings.TrimSpace(in.Role),
+		emailPrefix: strings.ToLower(strings.TrimSpace(in.EmailPrefix)),
+		sortBy:      strings.ToLower(strings.TrimSpace(in.SortBy)),
+	}
+
+	if q.limit <= 0 {
+		q.limit = defaultUsersLimit
+	}
+	if q.limit > maxUsersLimit {
+		q.limit = maxUsersLimit
+	}
+
+	if q.sortBy == "" {
+		q.sortBy = "id"
+	}
+	if !userSortColumns[q.sortBy] {
+		return nil, status.Errorf(codes.InvalidArgument, "sort_by must be one of id, email, name, created_at")
+	}
+	switch strings.ToLower(strings.TrimSpace(in.SortOrder)) {
+	case "", "asc":
+	case "desc":
+		q.desc = true
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "sort_order must be asc or desc")
+	}
+
+	if in.CreatedFrom != "" {
+		t, _, err := parseDateBound(in.CreatedFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid created_from: %v", err)
+		}
+		q.createdFrom = &t
+	}
+	if in.CreatedTo != "" {
+		t, dateOnly, err := parseDateBound(in.CreatedTo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid created_to: %v", err)
+		}
+		// tanggal saja berarti sampai akhir hari tersebut
+		if dateOnly {
+			t = t.AddDate(0, 0, 1)
+		}
+		q.createdTo = &t
+	}
+
+	if in.Cursor != "" {
+		c, err := decodeUsersCursor(in.Cursor)
+		if err != nil || c.SortBy != q.sortBy || c.Desc != q.desc {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		q.after = c
+	}
+
+	return q, nil
+}
+
+// parseDateBound menerima RFC3339 atau YYYY-MM-DD (UTC).
+func parseDateBound(v string) (time.Time, bool, error) {
+	v = strings.TrimSpace(v)
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, false, nil
+	}
+	t, err := time.Parse(dateLayout, v)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("expected RFC3339 or YYYY-MM-DD")
+	}
+	return t, true, nil
+}
+
+// filter dipakai untuk query data maupun total, jadi tidak memuat cursor.
+func (q *usersQuery) filter(db *gorm.DB) *gorm.DB {
+	db = db.Where("deactivated_at IS NULL")
+	if q.role != "" {
+		db = db.Where("role = ?", q.role)
+	}
+	if q.emailPrefix != "" {
+		db = db.Where("LOWER(email) LIKE ? ESCAPE '\\'", escapeLike(q.emailPrefix)+"%")
+	}
+	if q.createdFrom != nil {
+		db = db.Where("created_at >= ?", *q.createdFrom)
+	}
+	if q.createdTo != nil {
+		db = db.Where("created_at < ?", *q.createdTo)
+	}
+	return db
+}
+
+// page menambahkan posisi cursor dan urutan. id selalu jadi tie-breaker
+// supaya urutan stabil walaupun nilai kolom sort sama.
+func (q *usersQuery) page(db *gorm.DB) *gorm.DB {
+	op, dir := ">", "ASC"
+	if q.desc {
+		op, dir = "<", "DESC"
+	}
+
+	if c := q.after; c != nil {
+		if q.sortBy == "id" {
+			db = db.Where("id "+op+" ?", c.ID)
+		} else {
+			var v interface{} = c.Value
+			if q.sortBy == "created_at" {
+				t, _ := time.Parse(time.RFC3339Nano, c.Value)
+				v = t
+			}
+			db = db.Where("("+q.sortBy+" "+op+" ?) OR ("+q.sortBy+" = ? AND id "+op+" ?)", v, v, c.ID)
+		}
+	}
+
+	if q.sortBy == "id" {
+		return db.Order("id " + dir)
+	}
+	return db.Order(q.sortBy + " " + dir).Order("id " + dir)
+}
+
+func (q *usersQuery) nextCursor(last *model.User) string {
+	c := usersCursor{SortBy: q.sortBy, Desc: q.desc, ID: last.ID}
+	switch q.sortBy {
+	case "email":
+		c.Value = last.Email
+	case "name":
+		c.Value = last.Name
+	case "created_at":
+		c.Value = last.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// cacheKey adalah hash dari bentuk query yang sudah dinormalisasi (termasuk cursor).
+func (q *usersQuery) cacheKey() string {
+	shape := map[string]interface{}{
+		"limit":  q.limit,
+		"role":   q.role,
+		"email":  q.emailPrefix,
+		"from":   q.createdFrom,
+		"to":     q.createdTo,
+		"sort":   q.sortBy,
+		"desc":   q.desc,
+		"cursor": q.after,
+	}
+	b, _ := json.Marshal(shape)
+	sum := sha1.Sum(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func decodeUsersCursor(s string) (*usersCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var c usersCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
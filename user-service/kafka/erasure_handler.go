@@ -0,0 +1,82 @@
+package kafka
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+	"user-service/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ErasureCompletedEvent struct {
+	EventType string `json:"event_type"`
+	Data      struct {
+		RequestID   string           `json:"request_id"`
+		UserID      uint             `json:"user_id"`
+		Service     string           `json:"service"`
+		Status      string           `json:"status"`
+		Details     map[string]int64 `json:"details"`
+		Error       string           `json:"error"`
+		CompletedAt time.Time        `json:"completed_at"`
+	} `json:"data"`
+}
+
+// HandleErasureCompleted menyimpan laporan penghapusan data dari service lain.
+func HandleErasureCompleted(db *gorm.DB) func(data []byte) error {
+	return func(data []byte) error {
+		var event ErasureCompletedEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			log.Printf("❌ Failed decode event: %v", err)
+			return nil
+		}
+
+		log.Printf("📥 Received user.erasure_completed: request=%s service=%s status=%s",
+			event.Data.RequestID, event.Data.Service, event.Data.Status)
+
+		if event.Data.CompletedAt.IsZero() {
+			event.Data.CompletedAt = time.Now()
+		}
+
+		err := RecordErasureReport(db, &model.ErasureReport{
+			RequestID:   event.Data.RequestID,
+			Service:     event.Data.Service,
+			UserID:      event.Data.UserID,
+			Status:      event.Data.Status,
+			Details:     event.Data.Details,
+			Error:       event.Data.Error,
+			CompletedAt: event.Data.CompletedAt,
+		})
+		if err != nil {
+			log.Printf("Failed save erasure report: %v", err)
+		}
+		return err
+	}
+}
+
+// RecordErasureReport menyimpan (atau menimpa, kalau service mengulang) laporan satu service
+// dan menandai request selesai kalau semua service sudah melapor completed.
+func RecordErasureReport(db *gorm.DB, report *model.ErasureReport) error {
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "request_id"}, {Name: "service"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "details", "error", "completed_at"}),
+	}).Create(report).Error
+	if err != nil {
+		return err
+	}
+
+	var done int64
+	if err := db.Model(&model.ErasureReport{}).
+		Where("request_id = ? AND status = ? AND service IN ?", report.RequestID, "completed", model.ErasureServices).
+		Count(&done).Error; err != nil {
+		return err
+	}
+	if done < int64(len(model.ErasureServices)) {
+		return nil
+	}
+
+	return db.Model(&model.ErasureRequest{}).
+		Where("request_id = ? AND completed_at IS NULL", report.RequestID).
+		Update("completed_at", time.Now()).Error
+}
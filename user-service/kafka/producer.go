@@ -0,0 +1,98 @@
+package kafka
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// Source menandai event yang dikirim user-service, supaya consumer user-service
+// sendiri tidak memproses ulang event tersebut.
+const Source = "user-service"
+
+var Producer sarama.SyncProducer
+
+func InitProducer() {
+	broker := os.Getenv("KAFKA_BROKER")
+	if broker == "" {
+		broker = "kafka:9092"
+	}
+
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Retry.Max = 5
+
+	var err error
+	for i := 1; i <= 5; i++ {
+		Producer, err = sarama.NewSyncProducer([]string{broker}, config)
+		if err == nil {
+			log.Printf("Kafka producer connected to %s", broker)
+			return
+		}
+
+		log.Printf("Failed to connect to Kafka (try %d/5): %v", i, err)
+		time.Sleep(3 * time.Second)
+	}
+
+	log.Fatalf("❌ Could not connect to Kafka after 5 attempts: %v", err)
+}
+
+// Send mengirim pesan yang sudah di-encode dan mengembalikan error-nya ke pemanggil,
+// dipakai outbox relay yang perlu tahu apakah pesan benar-benar terkirim.
+// key menentukan partisi, sehingga event untuk user yang sama tetap berurutan.
+func Send(topic, key string, value []byte) error {
+	if Producer == nil {
+		return fmt.Errorf("kafka producer is nil")
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(value),
+	}
+	if key != "" {
+		msg.Key = sarama.StringEncoder(key)
+	}
+
+	_, _, err := Producer.SendMessage(msg)
+	return err
+}
+
+// publish mengirim event dengan format {"event_type": ..., "source": ..., "data": ...} ke topic.
+func publish(topic, eventType string, data interface{}) {
+	if Producer == nil {
+		log.Printf(" Kafka producer is nil — %s event not sent", eventType)
+		return
+	}
+
+	event := map[string]interface{}{
+		"event_type": eventType,
+		"source":     Source,
+		"data":       data,
+	}
+
+	messageBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Printf(" Failed to marshal %s event: %v", eventType, err)
+		return
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(messageBytes),
+	}
+
+	if _, _, err := Producer.SendMessage(msg); err != nil {
+		log.Printf(" Failed to send Kafka message (%s): %v", topic, err)
+	}
+}
+
+// PublishUserUpdatedEvent mengirim profil user terbaru (dikonsumsi search-service).
+func PublishUserUpdatedEvent(user interface{}) {
+	publish("user.updated", "user_updated", user)
+}
+
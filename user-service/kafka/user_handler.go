@@ -2,14 +2,18 @@ package kafka
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
+	"user-service/cache"
 	"user-service/model"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type UserCreatedEvent struct {
 	EventType string      `json:"event_type"`
+	Source    string      `json:"source,omitempty"`
 	Data      UserPayload `json:"data"`
 }
 
@@ -18,38 +22,126 @@ type UserPayload struct {
 	Email string `json:"email"`
 	Name  string `json:"name"`
 	Role  string `json:"role"`
+	// hanya dikirim auth-service; false tidak ikut terkirim (omitempty) dan berarti tidak di-suspend
+	Suspended       bool   `json:"suspended"`
+	SuspendedReason string `json:"suspended_reason"`
 }
 
-func HandleUserCreated(db *gorm.DB) func(data []byte) {
-	return func(data []byte) {
+func HandleUserCreated(db *gorm.DB) func(data []byte) error {
+	return func(data []byte) error {
 		var event UserCreatedEvent
 
 		if err := json.Unmarshal(data, &event); err != nil {
+			// pesan rusak tidak akan berhasil walaupun dicoba ulang
 			log.Printf("❌ Failed decode event: %v", err)
-			return
+			return nil
 		}
 
 		log.Printf("📥 Received user.created: %+v", event.Data)
 
-		user := model.User{
+		created, err := CreateUserIfMissing(db, model.User{
 			ID:    event.Data.ID,
 			Email: event.Data.Email,
 			Name:  event.Data.Name,
 			Role:  event.Data.Role,
+		})
+		if err != nil {
+			log.Printf("Failed save user: %v", err)
+			return err
+		}
+		if !created {
+			log.Printf("User already exists (%d), skip", event.Data.ID)
+			return nil
+		}
+
+		log.Printf("User saved: %s", event.Data.Email)
+		return nil
+	}
+}
+
+// CreateUserIfMissing menyimpan user replika dari auth-service. Idempotent: event yang
+// terkirim ulang (outbox / consumer at-least-once) maupun rekonsiliasi tidak membuat duplikat.
+func CreateUserIfMissing(db *gorm.DB, user model.User) (bool, error) {
+	res := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&user)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+
+	cache.InvalidateUsersList(cache.Ctx, cache.Redis)
+	return true, nil
+}
+
+// HandleUserUpdated menyinkronkan perubahan data user (mis. role) dari auth-service
+// dan membuang cache yang terkait.
+func HandleUserUpdated(db *gorm.DB) func(data []byte) error {
+	return func(data []byte) error {
+		var event UserCreatedEvent
+
+		if err := json.Unmarshal(data, &event); err != nil {
+			log.Printf("❌ Failed decode event: %v", err)
+			return nil
 		}
 
-		// Cek duplikat
-		var existing model.User
-		if err := db.Where("email = ?", user.Email).First(&existing).Error; err == nil {
-			log.Printf("User already exists (%s), skip", user.Email)
-			return
+		// event dari UpdateMe sudah diterapkan langsung ke DB
+		if event.Source == Source {
+			return nil
 		}
 
-		if err := db.Create(&user).Error; err != nil {
-			log.Printf("Failed save user: %v", err)
-			return
+		log.Printf("📥 Received user.updated: %+v", event.Data)
+
+		// user yang belum pernah dibuat (mis. belum verifikasi email) tidak dibuat di sini,
+		// dan user yang sudah dihapus tidak boleh terisi lagi oleh event lama yang diproses ulang
+		updates := suspensionUpdates(event.Data.Suspended, event.Data.SuspendedReason)
+		updates["email"] = event.Data.Email
+		updates["name"] = event.Data.Name
+		updates["role"] = event.Data.Role
+		res := db.Model(&model.User{}).Where("id = ? AND deactivated_at IS NULL", event.Data.ID).Updates(updates)
+		if res.Error != nil {
+			log.Printf("Failed update user: %v", res.Error)
+			return res.Error
 		}
+		if res.RowsAffected == 0 {
+			log.Printf("User %d not found, skip", event.Data.ID)
+			return nil
+		}
+
+		// clear cache
+		cache.Redis.Del(cache.Ctx,
+			fmt.Sprintf("user:%d", event.Data.ID),
+			fmt.Sprintf("user:me:%d", event.Data.ID),
+		)
+		cache.InvalidateUsersList(cache.Ctx, cache.Redis)
+
+		log.Printf("User updated: %s", event.Data.Email)
+		return nil
+	}
+}
+
+// SyncSuspension menyalin status suspend dari auth-service ke data user lokal.
+func SyncSuspension(db *gorm.DB, userID uint, suspended bool, reason string) error {
+	err := db.Model(&model.User{}).Where("id = ?", userID).Updates(suspensionUpdates(suspended, reason)).Error
+	if err != nil {
+		return err
+	}
 
-		log.Printf("User saved: %s", user.Email)
+	cache.Redis.Del(cache.Ctx,
+		fmt.Sprintf("user:%d", userID),
+		fmt.Sprintf("user:me:%d", userID),
+	)
+	cache.InvalidateUsersList(cache.Ctx, cache.Redis)
+	return nil
+}
+
+// suspensionUpdates mempertahankan waktu suspend yang sudah tercatat kalau event yang sama diproses ulang.
+func suspensionUpdates(suspended bool, reason string) map[string]interface{} {
+	if !suspended {
+		return map[string]interface{}{"suspended_at": nil, "suspended_reason": ""}
+	}
+	return map[string]interface{}{
+		"suspended_at":     gorm.Expr("COALESCE(suspended_at, NOW())"),
+		"suspended_reason": reason,
 	}
 }
@@ -1,16 +1,24 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
+	"strconv"
+	"time"
 	"user-service/cache"
+	"user-service/grpc_client"
 	"user-service/grpc_server"
+	"user-service/jwks"
 	kafka "user-service/kafka"
 	"user-service/middleware"
 	"user-service/model"
+	"user-service/outbox"
 	pb "user-service/proto/user"
+	"user-service/reconcile"
 	"user-service/routes"
+	"user-service/svcauth"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/logger"
@@ -39,7 +47,16 @@ func initDB() {
 		log.Fatal("failed to connect db:", err)
 	}
 
-	DB.AutoMigrate(&model.User{})
+	// user lama belum punya created_at; diisi waktu migrasi supaya filter & cursor tidak perlu menangani NULL
+	backfillCreatedAt := !DB.Migrator().HasColumn(&model.User{}, "created_at")
+
+	DB.AutoMigrate(&model.User{}, &model.ErasureRequest{}, &model.ErasureReport{}, &model.ExportJob{}, &model.OutboxEvent{})
+
+	if backfillCreatedAt {
+		if err := DB.Model(&model.User{}).Where("created_at IS NULL").Update("created_at", time.Now()).Error; err != nil {
+			log.Fatal("failed to backfill created_at:", err)
+		}
+	}
 	log.Println("Connected to user database:", name)
 }
 
@@ -47,9 +64,10 @@ func initDB() {
 // Main Function
 // --------------------
 func main() {
+	svcauth.Init()
 	initDB()
 	cache.ConnectRedis()
-	jwtSecret := "verysecretkey"
+	kafka.InitProducer()
 
 	// Jalankan HTTP (Fiber)
 	go func() {
@@ -75,8 +93,21 @@ func main() {
 		rdb := redis.NewClient(&redis.Options{
         Addr: redisAddr,
  	   	})
-		grpcServer := grpc.NewServer()
-		pb.RegisterUserServiceServer(grpcServer, &grpc_server.UserServer{DB: DB, JWTSecret: jwtSecret, Redis: rdb})
+		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(svcauth.UnaryInterceptor(svcauth.ServerPolicy)))
+		userServer := &grpc_server.UserServer{
+			DB:           DB,
+			Verifier:     jwks.Default(),
+			Redis:        rdb,
+			Auth:         grpc_client.NewAuthClient(),
+			Addresses:    grpc_client.NewAddressClient(),
+			Carts:        grpc_client.NewCartClient(),
+			Transactions: grpc_client.NewTransactionClient(),
+			Payments:     grpc_client.NewPaymentClient(),
+			ExportTTL:    getDurationEnv("DATA_EXPORT_TTL", 7*24*time.Hour),
+		}
+		userServer.FailInterruptedExports()
+		userServer.PurgeExpiredExports(context.Background(), time.Hour)
+		pb.RegisterUserServiceServer(grpcServer, userServer)
 
 		log.Println("gRPC running on :50051")
 		if err := grpcServer.Serve(listener); err != nil {
@@ -84,8 +115,28 @@ func main() {
 		}
 	}()
 
+	// event di outbox (mis. user.deleted) dikirim ke Kafka oleh relay
+	relay := &outbox.Relay{
+		DB:        DB,
+		Interval:  getDurationEnv("OUTBOX_RELAY_INTERVAL", time.Second),
+		BatchSize: getIntEnv("OUTBOX_RELAY_BATCH", 100),
+		Retention: getDurationEnv("OUTBOX_RETENTION", 7*24*time.Hour),
+	}
+	relay.Start(context.Background())
+
 	consumer := kafka.NewConsumer()
 	consumer.Consume("user.created", kafka.HandleUserCreated(DB))
+	consumer.Consume("user.updated", kafka.HandleUserUpdated(DB))
+	consumer.Consume("user.erasure_completed", kafka.HandleErasureCompleted(DB))
+	consumer.Start(context.Background())
+
+	// memperbaiki user yang terlewat replikasi dari auth-service
+	reconciler := &reconcile.Reconciler{
+		DB:       DB,
+		Auth:     grpc_client.NewAuthClient(),
+		Interval: getDurationEnv("USER_RECONCILE_INTERVAL", time.Hour),
+	}
+	reconciler.Start(context.Background())
 
 	select {}
 }
@@ -99,3 +150,35 @@ func getEnv(k, d string) string {
 	}
 	return d
 }
+
+// --------------------
+// Helper: getDurationEnv
+// --------------------
+func getDurationEnv(key string, def time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return def
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		log.Printf("invalid %s=%q, using default %s", key, val, def)
+		return def
+	}
+	return d
+}
+
+// --------------------
+// Helper: getIntEnv
+// --------------------
+func getIntEnv(key string, def int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return def
+	}
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		log.Printf("invalid %s=%q, using default %d", key, val, def)
+		return def
+	}
+	return n
+}
@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// ErasureRequest dibuat saat user menghapus akunnya (event user.deleted).
+type ErasureRequest struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	RequestID   string     `gorm:"uniqueIndex" json:"request_id"`
+	UserID      uint       `gorm:"index" json:"user_id"`
+	RequestedAt time.Time  `json:"requested_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"` // terisi setelah semua service melapor selesai
+}
+
+// ErasureReport adalah laporan penghapusan data dari satu service,
+// dipakai sebagai bukti penghapusan per service.
+type ErasureReport struct {
+	ID          uint             `gorm:"primaryKey" json:"id"`
+	RequestID   string           `gorm:"uniqueIndex:idx_erasure_request_service" json:"request_id"`
+	Service     string           `gorm:"uniqueIndex:idx_erasure_request_service" json:"service"`
+	UserID      uint             `gorm:"index" json:"user_id"`
+	Status      string           `json:"status"` // completed | failed
+	Details     map[string]int64 `gorm:"serializer:json" json:"details"`
+	Error       string           `json:"error,omitempty"`
+	CompletedAt time.Time        `json:"completed_at"`
+}
+
+// ErasureServices adalah service yang menyimpan data user dan wajib melapor
+// sebelum sebuah ErasureRequest dianggap selesai.
+var ErasureServices = []string{
+	"user-service",
+	"auth-service",
+	"address-service",
+	"cart-service",
+	"transaction-service",
+	"payment-service",
+	"search-service",
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// ExportJob adalah permintaan export data pribadi (DSAR) yang diproses secara async.
+// Archive berisi JSON hasil export dan dihapus setelah ExpiresAt.
+type ExportJob struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	JobID       string     `gorm:"uniqueIndex" json:"job_id"`
+	UserID      uint       `gorm:"index" json:"user_id"`
+	RequestedBy uint       `json:"requested_by"`
+	Status      string     `json:"status"` // pending | running | completed | failed
+	Error       string     `json:"error,omitempty"`
+	Archive     []byte     `json:"-"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// OutboxEvent adalah event Kafka yang ditulis dalam transaksi yang sama dengan
+// perubahan datanya; outbox relay yang mengirimkannya ke Kafka.
+type OutboxEvent struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	Topic       string     `json:"topic"`
+	EventType   string     `json:"event_type"`
+	Key         string     `json:"key"`
+	Payload     string     `gorm:"type:jsonb" json:"payload"`
+	Attempts    int        `gorm:"default:0" json:"attempts"`
+	LastError   string     `json:"last_error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	PublishedAt *time.Time `gorm:"index" json:"published_at,omitempty"`
+}
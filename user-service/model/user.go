@@ -1,9 +1,26 @@
 package model
 
+import "time"
+
 type User struct {
 	ID       uint   `gorm:"primaryKey" json:"id"`
 	Email    string `gorm:"uniqueIndex" json:"email"`
 	Password string `json:"-"`
 	Name     string `json:"name"`
 	Role     string `json:"role"` // "user" or "admin"
+
+	// profil, dikelola user-service sendiri (bukan dari auth-service)
+	Phone       string     `json:"phone"`
+	AvatarURL   string     `json:"avatar_url"`
+	DateOfBirth *time.Time `gorm:"type:date" json:"date_of_birth"`
+	Locale      string     `json:"locale"`
+
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+
+	// DeactivatedAt terisi setelah user menghapus akunnya; data pribadinya sudah dianonimkan
+	DeactivatedAt *time.Time `gorm:"index" json:"deactivated_at,omitempty"`
+
+	// salinan status suspend dari auth-service (auth-service yang menolak login / token)
+	SuspendedAt     *time.Time `json:"suspended_at,omitempty"`
+	SuspendedReason string     `json:"suspended_reason,omitempty"`
 }
@@ -0,0 +1,1004 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.27.1
+// source: proto/cart/cart.proto
+
+package cart
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Cart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       uint32                 `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Products      []*CartProduct         `protobuf:"bytes,3,rep,name=products,proto3" json:"products,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "active" | "paid"
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cart) Reset() {
+	*x = Cart{}
+	mi := &file_proto_cart_cart_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cart) ProtoMessage() {}
+
+func (x *Cart) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cart.ProtoReflect.Descriptor instead.
+func (*Cart) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Cart) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Cart) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Cart) GetProducts() []*CartProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *Cart) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Cart) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CartProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Qty           uint32                 `protobuf:"varint,2,opt,name=qty,proto3" json:"qty,omitempty"`
+	VariantId     uint32                 `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"` // 0 = produk tanpa variant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartProduct) Reset() {
+	*x = CartProduct{}
+	mi := &file_proto_cart_cart_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartProduct) ProtoMessage() {}
+
+func (x *CartProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartProduct.ProtoReflect.Descriptor instead.
+func (*CartProduct) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CartProduct) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CartProduct) GetQty() uint32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *CartProduct) GetVariantId() uint32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type CreateCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Products      []*CartProduct         `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCartRequest) Reset() {
+	*x = CreateCartRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCartRequest) ProtoMessage() {}
+
+func (x *CreateCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCartRequest.ProtoReflect.Descriptor instead.
+func (*CreateCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCartRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CreateCartRequest) GetProducts() []*CartProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type GetCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       uint32                 `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartRequest) Reset() {
+	*x = GetCartRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartRequest) ProtoMessage() {}
+
+func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
+func (*GetCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCartRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetCartRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+type ListCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCartRequest) Reset() {
+	*x = ListCartRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartRequest) ProtoMessage() {}
+
+func (x *ListCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartRequest.ProtoReflect.Descriptor instead.
+func (*ListCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListCartRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+type UpdateCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Products      []*CartProduct         `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCartRequest) Reset() {
+	*x = UpdateCartRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCartRequest) ProtoMessage() {}
+
+func (x *UpdateCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCartRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateCartRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCartRequest) GetProducts() []*CartProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *UpdateCartRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DeleteCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       uint32                 `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCartRequest) Reset() {
+	*x = DeleteCartRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCartRequest) ProtoMessage() {}
+
+func (x *DeleteCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCartRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteCartRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCartRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+type CartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartResponse) Reset() {
+	*x = CartResponse{}
+	mi := &file_proto_cart_cart_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartResponse) ProtoMessage() {}
+
+func (x *CartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartResponse.ProtoReflect.Descriptor instead.
+func (*CartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CartResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type ListCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Carts         []*Cart                `protobuf:"bytes,1,rep,name=carts,proto3" json:"carts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCartResponse) Reset() {
+	*x = ListCartResponse{}
+	mi := &file_proto_cart_cart_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartResponse) ProtoMessage() {}
+
+func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartResponse.ProtoReflect.Descriptor instead.
+func (*ListCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCartResponse) GetCarts() []*Cart {
+	if x != nil {
+		return x.Carts
+	}
+	return nil
+}
+
+type DeleteCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCartResponse) Reset() {
+	*x = DeleteCartResponse{}
+	mi := &file_proto_cart_cart_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCartResponse) ProtoMessage() {}
+
+func (x *DeleteCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCartResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteCartResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetAllCartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Carts         []*Cart                `protobuf:"bytes,1,rep,name=carts,proto3" json:"carts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllCartsResponse) Reset() {
+	*x = GetAllCartsResponse{}
+	mi := &file_proto_cart_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllCartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCartsResponse) ProtoMessage() {}
+
+func (x *GetAllCartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCartsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllCartsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAllCartsResponse) GetCarts() []*Cart {
+	if x != nil {
+		return x.Carts
+	}
+	return nil
+}
+
+type AddToCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Qty           uint32                 `protobuf:"varint,3,opt,name=qty,proto3" json:"qty,omitempty"`
+	VariantId     uint32                 `protobuf:"varint,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToCartRequest) Reset() {
+	*x = AddToCartRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToCartRequest) ProtoMessage() {}
+
+func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToCartRequest.ProtoReflect.Descriptor instead.
+func (*AddToCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddToCartRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *AddToCartRequest) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AddToCartRequest) GetQty() uint32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *AddToCartRequest) GetVariantId() uint32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type UpdateProductQtyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Qty           uint32                 `protobuf:"varint,3,opt,name=qty,proto3" json:"qty,omitempty"` //  qty == 0 → hdelete
+	VariantId     uint32                 `protobuf:"varint,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductQtyRequest) Reset() {
+	*x = UpdateProductQtyRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductQtyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductQtyRequest) ProtoMessage() {}
+
+func (x *UpdateProductQtyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductQtyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductQtyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateProductQtyRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *UpdateProductQtyRequest) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UpdateProductQtyRequest) GetQty() uint32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *UpdateProductQtyRequest) GetVariantId() uint32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type RemoveProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     uint32                 `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProductRequest) Reset() {
+	*x = RemoveProductRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProductRequest) ProtoMessage() {}
+
+func (x *RemoveProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProductRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveProductRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *RemoveProductRequest) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *RemoveProductRequest) GetVariantId() uint32 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type CheckoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutRequest) Reset() {
+	*x = CheckoutRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutRequest) ProtoMessage() {}
+
+func (x *CheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckoutRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+var File_proto_cart_cart_proto protoreflect.FileDescriptor
+
+const file_proto_cart_cart_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/cart/cart.proto\x12\x04cart\x1a\x1bgoogle/protobuf/empty.proto\"\x97\x01\n" +
+	"\x04Cart\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\rR\aownerId\x12-\n" +
+	"\bproducts\x18\x03 \x03(\v2\x11.cart.CartProductR\bproducts\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"N\n" +
+	"\vCartProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
+	"\x03qty\x18\x02 \x01(\rR\x03qty\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\rR\tvariantId\"]\n" +
+	"\x11CreateCartRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12-\n" +
+	"\bproducts\x18\x02 \x03(\v2\x11.cart.CartProductR\bproducts\";\n" +
+	"\x0eGetCartRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\rR\aownerId\",\n" +
+	"\x0fListCartRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId\"j\n" +
+	"\x11UpdateCartRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12-\n" +
+	"\bproducts\x18\x02 \x03(\v2\x11.cart.CartProductR\bproducts\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\">\n" +
+	"\x11DeleteCartRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\rR\aownerId\".\n" +
+	"\fCartResponse\x12\x1e\n" +
+	"\x04cart\x18\x01 \x01(\v2\n" +
+	".cart.CartR\x04cart\"4\n" +
+	"\x10ListCartResponse\x12 \n" +
+	"\x05carts\x18\x01 \x03(\v2\n" +
+	".cart.CartR\x05carts\".\n" +
+	"\x12DeleteCartResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"7\n" +
+	"\x13GetAllCartsResponse\x12 \n" +
+	"\x05carts\x18\x01 \x03(\v2\n" +
+	".cart.CartR\x05carts\"}\n" +
+	"\x10AddToCartRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\rR\tproductId\x12\x10\n" +
+	"\x03qty\x18\x03 \x01(\rR\x03qty\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\rR\tvariantId\"\x84\x01\n" +
+	"\x17UpdateProductQtyRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\rR\tproductId\x12\x10\n" +
+	"\x03qty\x18\x03 \x01(\rR\x03qty\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\rR\tvariantId\"o\n" +
+	"\x14RemoveProductRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\rR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\rR\tvariantId\",\n" +
+	"\x0fCheckoutRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId2\xfb\x04\n" +
+	"\vCartService\x129\n" +
+	"\n" +
+	"CreateCart\x12\x17.cart.CreateCartRequest\x1a\x12.cart.CartResponse\x123\n" +
+	"\aGetCart\x12\x14.cart.GetCartRequest\x1a\x12.cart.CartResponse\x12:\n" +
+	"\tListCarts\x12\x15.cart.ListCartRequest\x1a\x16.cart.ListCartResponse\x129\n" +
+	"\n" +
+	"UpdateCart\x12\x17.cart.UpdateCartRequest\x1a\x12.cart.CartResponse\x12?\n" +
+	"\n" +
+	"DeleteCart\x12\x17.cart.DeleteCartRequest\x1a\x18.cart.DeleteCartResponse\x12@\n" +
+	"\vGetAllCarts\x12\x16.google.protobuf.Empty\x1a\x19.cart.GetAllCartsResponse\x127\n" +
+	"\tAddToCart\x12\x16.cart.AddToCartRequest\x1a\x12.cart.CartResponse\x12E\n" +
+	"\x10UpdateProductQty\x12\x1d.cart.UpdateProductQtyRequest\x1a\x12.cart.CartResponse\x12G\n" +
+	"\x15RemoveProductFromCart\x12\x1a.cart.RemoveProductRequest\x1a\x12.cart.CartResponse\x129\n" +
+	"\fCheckoutCart\x12\x15.cart.CheckoutRequest\x1a\x12.cart.CartResponseB\rZ\vproto/cart/b\x06proto3"
+
+var (
+	file_proto_cart_cart_proto_rawDescOnce sync.Once
+	file_proto_cart_cart_proto_rawDescData []byte
+)
+
+func file_proto_cart_cart_proto_rawDescGZIP() []byte {
+	file_proto_cart_cart_proto_rawDescOnce.Do(func() {
+		file_proto_cart_cart_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_cart_cart_proto_rawDesc), len(file_proto_cart_cart_proto_rawDesc)))
+	})
+	return file_proto_cart_cart_proto_rawDescData
+}
+
+var file_proto_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_proto_cart_cart_proto_goTypes = []any{
+	(*Cart)(nil),                    // 0: cart.Cart
+	(*CartProduct)(nil),             // 1: cart.CartProduct
+	(*CreateCartRequest)(nil),       // 2: cart.CreateCartRequest
+	(*GetCartRequest)(nil),          // 3: cart.GetCartRequest
+	(*ListCartRequest)(nil),         // 4: cart.ListCartRequest
+	(*UpdateCartRequest)(nil),       // 5: cart.UpdateCartRequest
+	(*DeleteCartRequest)(nil),       // 6: cart.DeleteCartRequest
+	(*CartResponse)(nil),            // 7: cart.CartResponse
+	(*ListCartResponse)(nil),        // 8: cart.ListCartResponse
+	(*DeleteCartResponse)(nil),      // 9: cart.DeleteCartResponse
+	(*GetAllCartsResponse)(nil),     // 10: cart.GetAllCartsResponse
+	(*AddToCartRequest)(nil),        // 11: cart.AddToCartRequest
+	(*UpdateProductQtyRequest)(nil), // 12: cart.UpdateProductQtyRequest
+	(*RemoveProductRequest)(nil),    // 13: cart.RemoveProductRequest
+	(*CheckoutRequest)(nil),         // 14: cart.CheckoutRequest
+	(*emptypb.Empty)(nil),           // 15: google.protobuf.Empty
+}
+var file_proto_cart_cart_proto_depIdxs = []int32{
+	1,  // 0: cart.Cart.products:type_name -> cart.CartProduct
+	1,  // 1: cart.CreateCartRequest.products:type_name -> cart.CartProduct
+	1,  // 2: cart.UpdateCartRequest.products:type_name -> cart.CartProduct
+	0,  // 3: cart.CartResponse.cart:type_name -> cart.Cart
+	0,  // 4: cart.ListCartResponse.carts:type_name -> cart.Cart
+	0,  // 5: cart.GetAllCartsResponse.carts:type_name -> cart.Cart
+	2,  // 6: cart.CartService.CreateCart:input_type -> cart.CreateCartRequest
+	3,  // 7: cart.CartService.GetCart:input_type -> cart.GetCartRequest
+	4,  // 8: cart.CartService.ListCarts:input_type -> cart.ListCartRequest
+	5,  // 9: cart.CartService.UpdateCart:input_type -> cart.UpdateCartRequest
+	6,  // 10: cart.CartService.DeleteCart:input_type -> cart.DeleteCartRequest
+	15, // 11: cart.CartService.GetAllCarts:input_type -> google.protobuf.Empty
+	11, // 12: cart.CartService.AddToCart:input_type -> cart.AddToCartRequest
+	12, // 13: cart.CartService.UpdateProductQty:input_type -> cart.UpdateProductQtyRequest
+	13, // 14: cart.CartService.RemoveProductFromCart:input_type -> cart.RemoveProductRequest
+	14, // 15: cart.CartService.CheckoutCart:input_type -> cart.CheckoutRequest
+	7,  // 16: cart.CartService.CreateCart:output_type -> cart.CartResponse
+	7,  // 17: cart.CartService.GetCart:output_type -> cart.CartResponse
+	8,  // 18: cart.CartService.ListCarts:output_type -> cart.ListCartResponse
+	7,  // 19: cart.CartService.UpdateCart:output_type -> cart.CartResponse
+	9,  // 20: cart.CartService.DeleteCart:output_type -> cart.DeleteCartResponse
+	10, // 21: cart.CartService.GetAllCarts:output_type -> cart.GetAllCartsResponse
+	7,  // 22: cart.CartService.AddToCart:output_type -> cart.CartResponse
+	7,  // 23: cart.CartService.UpdateProductQty:output_type -> cart.CartResponse
+	7,  // 24: cart.CartService.RemoveProductFromCart:output_type -> cart.CartResponse
+	7,  // 25: cart.CartService.CheckoutCart:output_type -> cart.CartResponse
+	16, // [16:26] is the sub-list for method output_type
+	6,  // [6:16] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_cart_cart_proto_init() }
+func file_proto_cart_cart_proto_init() {
+	if File_proto_cart_cart_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_cart_cart_proto_rawDesc), len(file_proto_cart_cart_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_cart_cart_proto_goTypes,
+		DependencyIndexes: file_proto_cart_cart_proto_depIdxs,
+		MessageInfos:      file_proto_cart_cart_proto_msgTypes,
+	}.Build()
+	File_proto_cart_cart_proto = out.File
+	file_proto_cart_cart_proto_goTypes = nil
+	file_proto_cart_cart_proto_depIdxs = nil
+}
@@ -0,0 +1,104 @@
+package reconcile
+
+import (
+	"context"
+	"log"
+	"time"
+	"user-service/grpc_client"
+	"user-service/kafka"
+	"user-service/model"
+
+	"gorm.io/gorm"
+)
+
+const batchSize = 500
+
+// Reconciler membandingkan daftar user di auth-service dengan userdb secara berkala
+// dan membuat user yang hilang (mis. user.created yang gagal diproses).
+type Reconciler struct {
+	DB       *gorm.DB
+	Auth     *grpc_client.AuthClient
+	Interval time.Duration
+}
+
+func (r *Reconciler) Start(ctx context.Context) {
+	go func() {
+		// jalan sekali saat start, lalu berkala
+		r.run()
+
+		ticker := time.NewTicker(r.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.run()
+			}
+		}
+	}()
+}
+
+func (r *Reconciler) run() {
+	var afterID uint32
+	checked, created := 0, 0
+
+	for {
+		users, err := r.Auth.ListUsers(afterID, batchSize)
+		if err != nil {
+			log.Printf("reconcile: failed to list users from auth-service: %v", err)
+			return
+		}
+		if len(users) == 0 {
+			break
+		}
+
+		ids := make([]uint, 0, len(users))
+		for _, u := range users {
+			ids = append(ids, uint(u.Id))
+		}
+		var existing []uint
+		if err := r.DB.Model(&model.User{}).Where("id IN ?", ids).Pluck("id", &existing).Error; err != nil {
+			log.Printf("reconcile: failed to load local users: %v", err)
+			return
+		}
+		have := make(map[uint]bool, len(existing))
+		for _, id := range existing {
+			have[id] = true
+		}
+
+		for _, u := range users {
+			checked++
+			if have[uint(u.Id)] {
+				continue
+			}
+			user := model.User{
+				ID:              uint(u.Id),
+				Email:           u.Email,
+				Name:            u.Name,
+				Role:            u.Role,
+				SuspendedReason: u.SuspendedReason,
+			}
+			if u.Suspended {
+				now := time.Now()
+				user.SuspendedAt = &now
+			}
+			ok, err := kafka.CreateUserIfMissing(r.DB, user)
+			if err != nil {
+				log.Printf("reconcile: failed to create user %d: %v", u.Id, err)
+				continue
+			}
+			if ok {
+				created++
+				log.Printf("reconcile: user %d (%s) was missing, created", u.Id, u.Email)
+			}
+		}
+
+		afterID = users[len(users)-1].Id
+		if len(users) < batchSize {
+			break
+		}
+	}
+
+	log.Printf("reconcile: checked %d users, created %d missing", checked, created)
+}
@@ -15,7 +15,23 @@ func RegisterUserRoutes(app *fiber.App, authMiddleware fiber.Handler) {
 
 	// Hanya user terautentikasi yang bisa akses /me
 	u.Get("/me", authMiddleware, uc.Me)
+	u.Put("/me", authMiddleware, uc.UpdateMe)
+	u.Delete("/me", authMiddleware, uc.DeleteMe)
+
+	// Export data pribadi (async, polling status lalu download)
+	u.Post("/me/export", authMiddleware, uc.RequestExport)
+	u.Get("/exports/:job_id", authMiddleware, uc.GetExport)
+	u.Get("/exports/:job_id/download", authMiddleware, uc.DownloadExport)
 
 	// Hanya admin yang bisa akses semua user
-	u.Get("/all", authMiddleware, middleware.RoleRequired("admin"), uc.GetUsers)
+	u.Get("/all", authMiddleware, middleware.RequirePermission("user:read_all"), uc.GetUsers)
+
+	// Bukti penghapusan data per service (GDPR)
+	u.Get("/:id/erasure-report", authMiddleware, middleware.RequirePermission("user:read_all"), uc.ErasureReport)
+	u.Post("/:id/export", authMiddleware, middleware.RequirePermission("user:read_all"), uc.RequestExport)
+
+	// Admin / support: suspend, reactivate dan impersonate (read-only)
+	u.Post("/:id/suspend", authMiddleware, middleware.RequirePermission("user:suspend"), uc.SuspendUser)
+	u.Post("/:id/reactivate", authMiddleware, middleware.RequirePermission("user:suspend"), uc.ReactivateUser)
+	u.Post("/:id/impersonate", authMiddleware, middleware.RequirePermission("user:impersonate"), uc.Impersonate)
 }
@@ -0,0 +1,15 @@
+package svcauth
+
+// ServiceName dipakai sebagai identitas service ini di service token.
+const ServiceName = "user-service"
+
+// ServerPolicy adalah allowlist caller untuk RPC yang di-serve service ini.
+var ServerPolicy = Policy{
+	Methods: map[string][]string{
+		"/user.UserService/GetUserInfo": {
+			ServiceName, "address-service", "cart-service", "product-service",
+			"transaction-service", "payment-service",
+		},
+	},
+	Default: []string{ServiceName},
+}